@@ -0,0 +1,13 @@
+// Get the state of all BFD sessions
+data "opnsense_quagga_bfd_status" "bfd" {}
+
+// Verify that the BFD session to a peer is up
+check "bfd_up" {
+  assert {
+    condition = alltrue([
+      for s in data.opnsense_quagga_bfd_status.bfd.sessions : s.status == "up"
+      if s.neighbor_id == opnsense_quagga_bfd_neighbor.example0.id
+    ])
+    error_message = "BFD session to ${opnsense_quagga_bfd_neighbor.example0.peer_ip} is not up."
+  }
+}
@@ -0,0 +1,13 @@
+// Get the state of all BGP sessions
+data "opnsense_quagga_bgp_summary" "bgp" {}
+
+// Verify that a neighbor has reached the Established state
+check "bgp_established" {
+  assert {
+    condition = alltrue([
+      for n in data.opnsense_quagga_bgp_summary.bgp.neighbors : n.state == "Established"
+      if n.neighbor_id == opnsense_quagga_bgp_neighbor.example0.id
+    ])
+    error_message = "BGP session to ${opnsense_quagga_bgp_neighbor.example0.peer_ip} is not established."
+  }
+}
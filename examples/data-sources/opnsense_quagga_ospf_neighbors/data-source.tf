@@ -0,0 +1,13 @@
+// Get all OSPF adjacencies
+data "opnsense_quagga_ospf_neighbors" "ospf" {}
+
+// Verify that a full adjacency exists on the LAN interface
+check "ospf_full" {
+  assert {
+    condition = anytrue([
+      for n in data.opnsense_quagga_ospf_neighbors.ospf.neighbors : startswith(n.state, "Full")
+      if n.interface == "vtnet1"
+    ])
+    error_message = "No full OSPF adjacency on vtnet1."
+  }
+}
@@ -0,0 +1,12 @@
+// Get the status of all gateways
+data "opnsense_routing_gateway_status" "all" {}
+
+// Fail the run when a WAN gateway is not online
+check "wan_health" {
+  assert {
+    condition = alltrue([
+      for g in data.opnsense_routing_gateway_status.all.gateways : g.status == "none" if startswith(g.name, "WAN")
+    ])
+    error_message = "At least one WAN gateway is not online."
+  }
+}
@@ -0,0 +1,15 @@
+// Get all IPv4 routes from the kernel routing table
+data "opnsense_routing_table" "ipv4" {
+  proto = "ipv4"
+}
+
+// Verify that a static route has been installed
+check "route_installed" {
+  assert {
+    condition = contains(
+      [for r in data.opnsense_routing_table.ipv4.routes : r.destination],
+      opnsense_route.one_route.network
+    )
+    error_message = "Route ${opnsense_route.one_route.network} is not installed."
+  }
+}
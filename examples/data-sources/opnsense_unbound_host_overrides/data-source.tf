@@ -0,0 +1,20 @@
+// Get all enabled A records of a domain which are managed by the IPAM
+data "opnsense_unbound_host_overrides" "ipam" {
+  domain            = "example.com"
+  type              = "A"
+  enabled           = true
+  description_regex = "^ipam:"
+}
+
+// Look up a single host override by name
+data "opnsense_unbound_host_override" "nas" {
+  hostname = "nas"
+  domain   = "example.com"
+  type     = "A"
+}
+
+output "ipam_records" {
+  value = {
+    for o in data.opnsense_unbound_host_overrides.ipam.host_overrides : "${o.hostname}.${o.domain}" => o.server
+  }
+}
@@ -0,0 +1,20 @@
+resource "opnsense_unbound_host_override" "nas" {
+  hostname = "nas"
+  domain   = "example.com"
+  server   = "192.168.1.20"
+}
+
+// Resolve the name through the resolver of the firewall
+data "opnsense_unbound_resolve" "nas" {
+  hostname = "nas.example.com"
+
+  depends_on = [opnsense_unbound_host_override.nas]
+}
+
+// Verify that the host override resolves as intended
+check "nas_resolves" {
+  assert {
+    condition     = contains(data.opnsense_unbound_resolve.nas.addresses, opnsense_unbound_host_override.nas.server)
+    error_message = "nas.example.com does not resolve to ${opnsense_unbound_host_override.nas.server}."
+  }
+}
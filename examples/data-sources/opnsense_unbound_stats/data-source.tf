@@ -0,0 +1,10 @@
+// Get the runtime counters of the resolver
+data "opnsense_unbound_stats" "stats" {}
+
+// Verify that the cache is effective
+check "unbound_cache" {
+  assert {
+    condition     = data.opnsense_unbound_stats.stats.cache_hits >= data.opnsense_unbound_stats.stats.cache_misses
+    error_message = "Less than half of all queries were answered from the cache."
+  }
+}
@@ -0,0 +1,16 @@
+// Hand out custom DNS servers on the LAN
+resource "opnsense_dnsmasq_dhcp_option" "dns" {
+  option    = 6
+  interface = "lan"
+  value     = "192.168.1.2,192.168.1.3"
+
+  description = "DNS servers"
+}
+
+// Tag clients sending a specific vendor class
+resource "opnsense_dnsmasq_dhcp_option" "phones" {
+  type   = "match"
+  option = 60
+  value  = "Cisco*"
+  tags   = ["phones"]
+}
@@ -0,0 +1,20 @@
+// IPv4 range
+resource "opnsense_dnsmasq_dhcp_range" "lan" {
+  interface     = "lan"
+  start_address = "192.168.1.100"
+  end_address   = "192.168.1.199"
+  lease_time    = 3600
+
+  description = "LAN clients"
+}
+
+// IPv6 range on a tracked interface
+resource "opnsense_dnsmasq_dhcp_range" "lan6" {
+  interface     = "lan"
+  start_address = "::1000"
+  end_address   = "::1fff"
+  constructor   = "lan"
+  prefix_length = 64
+
+  description = "LAN clients (IPv6)"
+}
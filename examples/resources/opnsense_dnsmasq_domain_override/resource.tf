@@ -0,0 +1,7 @@
+// Forward queries for an internal domain to its own DNS server
+resource "opnsense_dnsmasq_domain_override" "corp" {
+  domain = "corp.example.com"
+  ip     = "10.0.0.53"
+
+  description = "Corporate DNS"
+}
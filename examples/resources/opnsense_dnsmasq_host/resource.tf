@@ -0,0 +1,19 @@
+// DNS only host override
+resource "opnsense_dnsmasq_host" "nas" {
+  hostname     = "nas"
+  domain       = "example.com"
+  ip_addresses = ["192.168.1.10", "fd00::10"]
+  aliases      = ["files.example.com"]
+
+  description = "NAS"
+}
+
+// Static DHCP reservation
+resource "opnsense_dnsmasq_host" "printer" {
+  hostname           = "printer"
+  ip_addresses       = ["192.168.1.20"]
+  hardware_addresses = ["00:25:96:12:34:56"]
+  lease_time         = 86400
+
+  description = "Printer"
+}
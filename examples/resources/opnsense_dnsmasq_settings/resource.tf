@@ -0,0 +1,9 @@
+// Run Dnsmasq as DHCP server next to Unbound, which keeps port 53
+resource "opnsense_dnsmasq_settings" "settings" {
+  enabled    = true
+  port       = 0
+  interfaces = ["lan"]
+
+  dhcp_authoritative = true
+  dhcp_domain        = "lan.example.com"
+}
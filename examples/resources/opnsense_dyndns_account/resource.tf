@@ -0,0 +1,36 @@
+variable "cloudflare_api_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "dyndns_password" {
+  type      = string
+  sensitive = true
+}
+
+// Register the WAN address of a branch with Cloudflare
+resource "opnsense_dyndns_account" "branch" {
+  service   = "cloudflare"
+  username  = "token"
+  password  = var.cloudflare_api_token
+  zone      = "example.com"
+  hostnames = ["branch1.example.com"]
+
+  check_ip  = "if"
+  interface = "wan"
+
+  description = "Branch 1 WAN"
+}
+
+// Custom dyndns2 compatible provider, behind NAT
+resource "opnsense_dyndns_account" "custom" {
+  service   = "custom"
+  protocol  = "dyndns2"
+  server    = "update.example.net"
+  username  = "branch1"
+  password  = var.dyndns_password
+  hostnames = ["branch1.dyn.example.net"]
+
+  check_ip  = "web_ipify-ipv4"
+  interface = "wan"
+}
@@ -0,0 +1,5 @@
+// Enable dynamic DNS updates
+resource "opnsense_dyndns_settings" "settings" {
+  enabled  = true
+  interval = 300
+}
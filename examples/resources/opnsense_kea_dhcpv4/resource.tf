@@ -0,0 +1,18 @@
+// Standalone DHCP server on the LAN
+resource "opnsense_kea_dhcpv4" "dhcpv4" {
+  enabled        = true
+  interfaces     = ["lan"]
+  valid_lifetime = 7200
+}
+
+// HA primary, with its partner configured as opnsense_kea_peer
+resource "opnsense_kea_dhcpv4" "ha" {
+  enabled    = true
+  interfaces = ["lan"]
+
+  ha_enabled          = true
+  ha_this_server_name = "fw1"
+
+  control_agent_enabled = true
+  control_agent_host    = "192.0.2.1"
+}
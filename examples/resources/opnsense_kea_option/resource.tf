@@ -0,0 +1,16 @@
+// Vendor specific information for access points on all subnets
+resource "opnsense_kea_option" "ap_controller" {
+  code        = 43
+  type        = "hex"
+  data        = "f1:04:0a:08:00:05"
+  always_send = true
+
+  description = "AP controller"
+}
+
+// TFTP server name for all subnets
+resource "opnsense_kea_option" "tftp" {
+  code = 66
+  type = "string"
+  data = "tftp.example.com"
+}
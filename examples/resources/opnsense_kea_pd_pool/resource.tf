@@ -0,0 +1,16 @@
+// Delegate /64 prefixes out of a /56 to downstream routers
+resource "opnsense_kea_pd_pool" "lan" {
+  subnet_id = opnsense_kea_subnet6.lan.id
+
+  prefix           = "2001:db8:100::"
+  prefix_length    = 56
+  delegated_length = 64
+
+  description = "Downstream routers"
+}
+
+// LAN subnet example
+resource "opnsense_kea_subnet6" "lan" {
+  subnet    = "2001:db8:1::/64"
+  interface = "lan"
+}
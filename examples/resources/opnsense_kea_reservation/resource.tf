@@ -8,6 +8,22 @@ resource "opnsense_kea_reservation" "test" {
   description = "example host"
 }
 
+// Reservation with a custom option, e.g. a PXE boot server for one host
+resource "opnsense_kea_reservation" "pxe" {
+  subnet_id = resource.opnsense_kea_subnet.lan.id
+
+  ip_address = "10.8.2.103"
+  mac_address = "00:25:96:12:34:56"
+
+  options = [
+    {
+      code = 66
+      type = "string"
+      data = "pxe.example.com"
+    }
+  ]
+}
+
 // LAN subnet example
 resource "opnsense_kea_subnet" "lan" {
   subnet = "10.8.0.0/16"
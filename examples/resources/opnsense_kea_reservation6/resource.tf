@@ -0,0 +1,16 @@
+// Small example
+resource "opnsense_kea_reservation6" "test" {
+  subnet_id = opnsense_kea_subnet6.lan.id
+
+  ip_address = "2001:db8:1::102"
+  duid       = "00:01:00:01:2b:3c:4d:5e:00:25:96:12:34:55"
+  hostname   = "example"
+
+  description = "example host"
+}
+
+// LAN subnet example
+resource "opnsense_kea_subnet6" "lan" {
+  subnet    = "2001:db8:1::/64"
+  interface = "lan"
+}
@@ -59,5 +59,19 @@ resource "opnsense_kea_subnet" "example" {
   tfpt_server = "tfpt.example.com"
   tftp_bootfile = "bootfile.txt"
 
+  options = [
+    {
+      code = 150
+      type = "ip-list"
+      data = "10.8.0.20,10.8.0.21"
+    },
+    {
+      code        = 42
+      type        = "ip"
+      data        = "10.10.101.10"
+      always_send = true
+    }
+  ]
+
   description = "EXAMPLE"
 }
@@ -0,0 +1,11 @@
+// LAN subnet example
+resource "opnsense_kea_subnet6" "lan" {
+  subnet    = "2001:db8:1::/64"
+  interface = "lan"
+  pools     = ["2001:db8:1::1000 - 2001:db8:1::1fff"]
+
+  dns_servers   = ["2001:db8:1::1"]
+  domain_search = ["example.com"]
+
+  description = "LAN"
+}
@@ -0,0 +1,4 @@
+// Enable BFD
+resource "opnsense_quagga_bfd" "bfd" {
+  enabled = true
+}
@@ -0,0 +1,29 @@
+// Configure a single-hop BFD peer
+resource "opnsense_quagga_bfd_neighbor" "example0" {
+  description = "bfd0"
+
+  peer_ip   = "10.0.0.2"
+  interface = "lan"
+
+  detect_multiplier = 3
+  receive_interval  = 300
+  transmit_interval = 300
+}
+
+// Configure a multi-hop BFD peer
+resource "opnsense_quagga_bfd_neighbor" "example1" {
+  description = "bfd1"
+
+  peer_ip   = "192.0.2.10"
+  local_ip  = "10.0.0.1"
+  multi_hop = true
+}
+
+// Track the BGP session with BFD
+resource "opnsense_quagga_bgp_neighbor" "example0" {
+  description = "neighbor0"
+
+  peer_ip   = opnsense_quagga_bfd_neighbor.example0.peer_ip
+  remote_as = 65010
+  bfd       = true
+}
@@ -0,0 +1,33 @@
+// Configure a route map
+resource "opnsense_quagga_bgp_routemap" "example0" {
+  description = "routemap0"
+
+  name   = "example0"
+  action = "permit"
+
+  route_map_id = 100
+}
+
+// Configure the BGP instance
+resource "opnsense_quagga_bgp" "bgp" {
+  as_number = 65001
+  router_id = "10.0.0.1"
+
+  networks = [
+    "10.0.0.0/24",
+    "10.0.1.0/24",
+  ]
+
+  redistribution = [
+    {
+      protocol = "connected"
+    },
+    {
+      protocol  = "static"
+      route_map = opnsense_quagga_bgp_routemap.example0.id
+    },
+  ]
+
+  graceful_restart     = true
+  log_neighbor_changes = true
+}
@@ -0,0 +1,15 @@
+// Configure a standard community list
+resource "opnsense_quagga_bgp_communitylist_set" "blackhole" {
+  description = "blackhole communities"
+
+  number = 10
+
+  entries = [
+    {
+      community = "65535:666"
+    },
+    {
+      community = "65000:666"
+    },
+  ]
+}
@@ -56,4 +56,11 @@ resource "opnsense_quagga_bgp_neighbor" "example0" {
 
   prefix_list_in = opnsense_quagga_bgp_prefixlist.example0.id
   route_map_out = opnsense_quagga_bgp_routemap.example0.id
+
+  ipv4_unicast                 = true
+  ipv6_unicast                 = true
+  allowas_in                   = 2
+  soft_reconfiguration_inbound = true
+  maximum_prefix               = 1000
+  local_as                     = 65001
 }
@@ -0,0 +1,38 @@
+// Configure a route map
+resource "opnsense_quagga_bgp_routemap" "example0" {
+  enabled     = false
+  description = "routemap0"
+
+  name   = "example0"
+  action = "permit"
+
+  route_map_id = 100
+  set = "local-preference 300"
+}
+
+// Configure a peer group
+resource "opnsense_quagga_bgp_peergroup" "example0" {
+  enabled     = false
+
+  description = "peergroup0"
+  name        = "transit"
+
+  remote_as     = 65010
+  update_source = "wan"
+  next_hop_self = true
+
+  keep_alive    = 30
+  hold_down     = 90
+  connect_timer = 10
+
+  route_map_in = opnsense_quagga_bgp_routemap.example0.id
+}
+
+// Configure a neighbor that inherits the peer group settings
+resource "opnsense_quagga_bgp_neighbor" "example0" {
+  enabled     = false
+
+  description = "neighbor0"
+  peer_ip     = "1.1.1.1"
+  peer_group  = opnsense_quagga_bgp_peergroup.example0.id
+}
@@ -0,0 +1,24 @@
+// Configure a prefix list accepting customer networks
+resource "opnsense_quagga_bgp_prefixlist_set" "customers" {
+  description = "customer networks"
+
+  name       = "customers"
+  ip_version = "IPv4"
+
+  entries = [
+    {
+      action  = "deny"
+      network = "10.0.0.0/8"
+      le      = 32
+    },
+    {
+      network = "198.51.100.0/22"
+      ge      = 24
+      le      = 24
+    },
+    {
+      seq_number = 100
+      network    = "203.0.113.0/24"
+    },
+  ]
+}
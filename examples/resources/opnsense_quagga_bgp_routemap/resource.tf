@@ -55,5 +55,24 @@ resource "opnsense_quagga_bgp_routemap" "example0" {
     opnsense_quagga_bgp_communitylist.example0.id
   ]
 
-  set = "local-preference 300"
+  set_clause = {
+    local_preference = 300
+  }
+}
+
+// Configure a route map which tags routes with communities
+resource "opnsense_quagga_bgp_routemap" "example1" {
+  description = "routemap1"
+
+  name   = "example1"
+  action = "permit"
+
+  route_map_id = 200
+
+  set_clause = {
+    community = {
+      values   = ["65000:100", "no-export"]
+      additive = true
+    }
+  }
 }
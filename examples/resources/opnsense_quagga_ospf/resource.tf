@@ -0,0 +1,10 @@
+// Configure the OSPF instance
+resource "opnsense_quagga_ospf" "ospf" {
+  router_id = "10.0.0.1"
+
+  passive_interfaces = ["lan"]
+  redistribute       = ["connected", "static"]
+
+  default_information_originate = true
+  reference_bandwidth           = 10000
+}
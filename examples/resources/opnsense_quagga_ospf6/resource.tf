@@ -0,0 +1,6 @@
+// Configure the OSPFv3 instance
+resource "opnsense_quagga_ospf6" "ospf6" {
+  router_id = "10.0.0.1"
+
+  redistribute = ["connected"]
+}
@@ -0,0 +1,17 @@
+// Run OSPFv3 on the WAN interface
+resource "opnsense_quagga_ospf6_interface" "example0" {
+  interface = "wan"
+  area      = "0.0.0.0"
+
+  cost           = 10
+  hello_interval = 5
+  dead_interval  = 20
+  network_type   = "point-to-point"
+}
+
+// Advertise the LAN network without forming adjacencies on it
+resource "opnsense_quagga_ospf6_interface" "example1" {
+  interface = "lan"
+  area      = "0.0.0.0"
+  passive   = true
+}
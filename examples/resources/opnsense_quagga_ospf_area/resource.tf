@@ -0,0 +1,8 @@
+// Configure a totally stubby area
+resource "opnsense_quagga_ospf_area" "example0" {
+  description = "area0"
+
+  area       = "0.0.0.1"
+  type       = "stub"
+  no_summary = true
+}
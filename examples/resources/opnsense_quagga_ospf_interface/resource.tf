@@ -2,8 +2,8 @@
 resource "opnsense_quagga_ospf_interface" "example0" {
   enabled = true
   interfacename = "opt1"
-  authtype = ""
-  authkey = ""
+  authtype = "message-digest"
+  authkey = "s3cr3tkey"
   authkey_id = 1
   area = ""
   cost = ""
@@ -0,0 +1,24 @@
+// Configure a prefix list
+resource "opnsense_quagga_bgp_prefixlist" "example0" {
+  description = "prefixlist0"
+
+  name    = "example0"
+  number  = 10
+  action  = "permit"
+  network = "10.1.0.0/16"
+}
+
+// Run OSPF on a network in the backbone area
+resource "opnsense_quagga_ospf_network" "example0" {
+  network = "10.0.0.0/24"
+  area    = "0.0.0.0"
+}
+
+// Run OSPF on a network in another area, summarizing its routes
+resource "opnsense_quagga_ospf_network" "example1" {
+  network    = "10.1.0.0/24"
+  area       = "0.0.0.1"
+  area_range = "10.1.0.0/16"
+
+  prefix_list_in = opnsense_quagga_bgp_prefixlist.example0.id
+}
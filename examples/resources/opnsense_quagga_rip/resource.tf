@@ -0,0 +1,10 @@
+// Configure RIPv2 towards legacy branch equipment
+resource "opnsense_quagga_rip" "rip" {
+  version = 2
+
+  networks           = ["10.30.0.0/16"]
+  passive_interfaces = ["lan"]
+
+  redistribute   = ["connected", "static"]
+  default_metric = 2
+}
@@ -0,0 +1,17 @@
+// Configure a static route via a gateway
+resource "opnsense_quagga_static_route" "example0" {
+  description = "static0"
+
+  network  = "10.20.0.0/16"
+  gateway  = "10.0.0.2"
+  distance = 200
+  bfd      = true
+}
+
+// Configure a route out of an interface
+resource "opnsense_quagga_static_route" "example1" {
+  description = "static1"
+
+  network   = "192.168.100.0/24"
+  interface = "opt1"
+}
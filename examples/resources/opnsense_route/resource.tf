@@ -13,6 +13,9 @@ resource "opnsense_route" "two_route" {
   network = "10.10.0.0/24"
 }
 
-
-
-
+// IPv6 route, the gateway must be an IPv6 gateway
+resource "opnsense_route" "v6_route" {
+  description = "Example IPv6 route"
+  gateway = "WAN_DHCP6"
+  network = "2001:db8:10::/48"
+}
@@ -0,0 +1,20 @@
+// Allow a tenant VLAN to query the resolver
+resource "opnsense_unbound_acl" "tenant_a" {
+  name   = "tenant-a"
+  action = "allow"
+
+  networks = [
+    "10.100.0.0/24",
+    "2001:db8:100::/64",
+  ]
+
+  description = "Tenant A"
+}
+
+// Only answer local data for a guest network
+resource "opnsense_unbound_acl" "guests" {
+  name   = "guests"
+  action = "refuse_nonlocal"
+
+  networks = ["10.200.0.0/24"]
+}
@@ -0,0 +1,12 @@
+resource "opnsense_unbound_host_override" "nas" {
+  hostname = "nas"
+  domain   = "example.com"
+  server   = "192.168.1.20"
+}
+
+// Flush the cache whenever the override changes
+resource "opnsense_unbound_cache_flush" "nas" {
+  triggers = {
+    server = opnsense_unbound_host_override.nas.server
+  }
+}
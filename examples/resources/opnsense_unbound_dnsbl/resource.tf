@@ -0,0 +1,23 @@
+// Block ads and malware for all clients
+resource "opnsense_unbound_dnsbl" "default" {
+  description = "Network-wide blocking"
+
+  predefined_lists = ["ag", "el"]
+  custom_lists     = ["https://example.com/blocklist.txt"]
+
+  allowlist_domains = ["example.org"]
+  blocklist_domains = ["tracker.example.net"]
+  wildcard_domains  = ["ads.example.com"]
+
+  nxdomain = true
+}
+
+// Stricter blocking for a guest network
+resource "opnsense_unbound_dnsbl" "guests" {
+  description = "Guests"
+
+  predefined_lists = ["ag", "el", "hgz002"]
+  source_networks  = ["10.200.0.0/24"]
+
+  destination_address = "10.200.0.1"
+}
@@ -2,7 +2,7 @@
 resource "opnsense_unbound_forward" "query" {
   domain = "example.lan"
   server_ip = "192.168.1.2"
-  server_port = 853
+  server_port = 53
 }
 
 // DoT forward
@@ -12,6 +12,6 @@ resource "opnsense_unbound_forward" "dot" {
 
   domain = "example.dev"
   server_ip = "192.168.1.1"
-  server_port = 53
+  server_port = 853
   verify_cn = "example.dev"
 }
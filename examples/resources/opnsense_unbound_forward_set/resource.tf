@@ -0,0 +1,36 @@
+// Forward all queries over TLS, Cloudflare first
+resource "opnsense_unbound_forward_set" "dot" {
+  domain = ""
+  type = "dot"
+
+  // Forwarding to the system nameservers would take precedence over this set
+  disable_system_nameserver_forwarding = true
+
+  servers = [
+    {
+      ip = "1.1.1.1"
+      port = 853
+      verify_cn = "cloudflare-dns.com"
+    },
+    {
+      ip = "1.0.0.1"
+      port = 853
+      verify_cn = "cloudflare-dns.com"
+    },
+    {
+      ip = "9.9.9.9"
+      port = 853
+      verify_cn = "dns.quad9.net"
+    },
+  ]
+}
+
+// Forward queries for an internal domain to the domain controllers
+resource "opnsense_unbound_forward_set" "corp" {
+  domain = "corp.example.com"
+
+  servers = [
+    { ip = "10.0.0.10" },
+    { ip = "10.0.0.11" },
+  ]
+}
@@ -30,3 +30,29 @@ resource "opnsense_unbound_host_override" "mx_override" {
   mx_priority = 10
   mx_host = "mail.example.dev"
 }
+
+// 'TXT' record
+resource "opnsense_unbound_host_override" "txt_override" {
+  enabled = true
+  description = "SPF record"
+
+  type = "TXT"
+  hostname = "mail"
+  domain = "example.com"
+
+  txt_data = "v=spf1 mx -all"
+}
+
+// CNAME-style record, 'www.example.com' resolves like 'host.example.com'
+resource "opnsense_unbound_host_override" "cname_target" {
+  hostname = "host"
+  domain = "example.com"
+  server = "192.168.1.10"
+}
+
+resource "opnsense_unbound_host_alias" "cname_alias" {
+  override = opnsense_unbound_host_override.cname_target.id
+
+  hostname = "www"
+  domain = "example.com"
+}
@@ -0,0 +1,15 @@
+// Configure the Unbound resolver
+resource "opnsense_unbound_settings" "settings" {
+  port       = 53
+  interfaces = ["lan", "opt1"]
+
+  dnssec = true
+
+  register_dhcp_leases          = true
+  register_dhcp_static_mappings = true
+
+  message_cache_size = "8m"
+  rrset_cache_size   = "16m"
+  prefetch           = true
+  serve_expired      = true
+}
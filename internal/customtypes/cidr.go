@@ -0,0 +1,153 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = CIDRType{}
+var _ basetypes.StringValuableWithSemanticEquals = CIDRValue{}
+var _ xattr.ValidateableAttribute = CIDRValue{}
+
+// CIDRType is a string type for IPv4 or IPv6 networks in CIDR notation. Values are
+// semantically equal when they describe the same network, so `10.0.0.1/24` equals
+// `10.0.0.0/24`, and `2001:db8::/32` equals `2001:0db8:0000::/32`.
+type CIDRType struct {
+	basetypes.StringType
+}
+
+func (t CIDRType) Equal(o attr.Type) bool {
+	other, ok := o.(CIDRType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t CIDRType) String() string {
+	return "customtypes.CIDRType"
+}
+
+func (t CIDRType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CIDRValue{StringValue: in}, nil
+}
+
+func (t CIDRType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return CIDRValue{StringValue: stringValue}, nil
+}
+
+func (t CIDRType) ValueType(ctx context.Context) attr.Value {
+	return CIDRValue{}
+}
+
+// CIDRValue is a value of CIDRType.
+type CIDRValue struct {
+	basetypes.StringValue
+}
+
+func NewCIDRValue(s string) CIDRValue {
+	return CIDRValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func NewCIDRNull() CIDRValue {
+	return CIDRValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v CIDRValue) Equal(o attr.Value) bool {
+	other, ok := o.(CIDRValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v CIDRValue) Type(ctx context.Context) attr.Type {
+	return CIDRType{}
+}
+
+func (v CIDRValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CIDRValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newPrefix, err := netip.ParsePrefix(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldPrefix.Masked() == newPrefix.Masked(), diags
+}
+
+func (v CIDRValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("A string value was provided that is not a valid IPv4 or IPv6 network in CIDR notation (e.g. `10.0.0.0/24`).\n\nGiven value: %s\nError: %s", v.ValueString(), err),
+		)
+		return
+	}
+
+	if prefix != prefix.Masked() {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"CIDR Has Host Bits Set",
+			fmt.Sprintf("The network %s has host bits set, it will be configured as %s.", v.ValueString(), prefix.Masked()),
+		)
+	}
+}
+
+// ValuePrefix returns the parsed network. ok is false if the value is null, unknown or not a valid CIDR.
+func (v CIDRValue) ValuePrefix() (prefix netip.Prefix, ok bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return netip.Prefix{}, false
+	}
+	prefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return prefix, true
+}
+
+// ValueMasked returns the network with its host bits cleared, or the raw string if it cannot be parsed.
+func (v CIDRValue) ValueMasked() string {
+	prefix, ok := v.ValuePrefix()
+	if !ok {
+		return v.ValueString()
+	}
+	return prefix.Masked().String()
+}
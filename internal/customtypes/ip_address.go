@@ -0,0 +1,105 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = IPAddressType{}
+var _ basetypes.StringValuableWithSemanticEquals = IPAddressValue{}
+
+// IPAddressType is a string type for IPv4 or IPv6 addresses. Values are semantically
+// equal when they describe the same address, so `2001:DB8::1` equals `2001:db8:0:0::1`.
+type IPAddressType struct {
+	basetypes.StringType
+}
+
+func (t IPAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IPAddressType) String() string {
+	return "customtypes.IPAddressType"
+}
+
+func (t IPAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddressValue{StringValue: in}, nil
+}
+
+func (t IPAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return IPAddressValue{StringValue: stringValue}, nil
+}
+
+func (t IPAddressType) ValueType(ctx context.Context) attr.Value {
+	return IPAddressValue{}
+}
+
+// IPAddressValue is a value of IPAddressType.
+type IPAddressValue struct {
+	basetypes.StringValue
+}
+
+func NewIPAddressValue(s string) IPAddressValue {
+	return IPAddressValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func NewIPAddressNull() IPAddressValue {
+	return IPAddressValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v IPAddressValue) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddressValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v IPAddressValue) Type(ctx context.Context) attr.Type {
+	return IPAddressType{}
+}
+
+func (v IPAddressValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddressValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newAddr, err := netip.ParseAddr(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldAddr == newAddr, diags
+}
@@ -0,0 +1,85 @@
+package opnsense
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/browningluke/opnsense-go/pkg/interfaces"
+	"github.com/browningluke/opnsense-go/pkg/wireguard"
+	"terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/opnsense/dyndns"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/opnsense/rest"
+	"terraform-provider-opnsense/internal/opnsense/routes"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+)
+
+// Client mirrors the opnsense-go client interface. Controllers for endpoints not
+// (yet) covered by opnsense-go embed the upstream controller and extend it, services
+// missing from opnsense-go entirely (e.g. Dnsmasq) get a controller of their own.
+type Client interface {
+	Diagnostics() *diagnostics.Controller
+	Dnsmasq() *dnsmasq.Controller
+	DynDNS() *dyndns.Controller
+	Firewall() *firewall.Controller
+	Interfaces() *interfaces.Controller
+	Kea() *kea.Controller
+	Quagga() *quagga.Controller
+	Routes() *routes.Controller
+	Unbound() *unbound.Controller
+	Wireguard() *wireguard.Controller
+}
+
+type client struct {
+	a *api.Client
+	r *rest.Client
+}
+
+// NewClient creates a new API client.
+func NewClient(options api.Options) Client {
+	return &client{
+		a: api.NewClient(options),
+		r: rest.NewClient(options),
+	}
+}
+
+func (c *client) Diagnostics() *diagnostics.Controller {
+	return diagnostics.NewController(c.a, c.r)
+}
+
+func (c *client) Dnsmasq() *dnsmasq.Controller {
+	return &dnsmasq.Controller{Api: c.a}
+}
+
+func (c *client) DynDNS() *dyndns.Controller {
+	return &dyndns.Controller{Api: c.a}
+}
+
+func (c *client) Firewall() *firewall.Controller {
+	return &firewall.Controller{Api: c.a}
+}
+
+func (c *client) Interfaces() *interfaces.Controller {
+	return &interfaces.Controller{Api: c.a}
+}
+
+func (c *client) Kea() *kea.Controller {
+	return kea.NewController(c.a)
+}
+
+func (c *client) Quagga() *quagga.Controller {
+	return quagga.NewController(c.a, c.r)
+}
+
+func (c *client) Routes() *routes.Controller {
+	return routes.NewController(c.a, c.r)
+}
+
+func (c *client) Unbound() *unbound.Controller {
+	return unbound.NewController(c.a, c.r)
+}
+
+func (c *client) Wireguard() *wireguard.Controller {
+	return &wireguard.Controller{Api: c.a}
+}
@@ -0,0 +1,20 @@
+package diagnostics
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/diagnostics"
+	"terraform-provider-opnsense/internal/opnsense/rest"
+)
+
+// Controller for diagnostics
+type Controller struct {
+	*diagnostics.Controller
+	Rest *rest.Client
+}
+
+func NewController(a *api.Client, r *rest.Client) *Controller {
+	return &Controller{
+		Controller: &diagnostics.Controller{Api: a},
+		Rest:       r,
+	}
+}
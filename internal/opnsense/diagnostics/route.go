@@ -0,0 +1,31 @@
+package diagnostics
+
+import (
+	"context"
+)
+
+const routesEndpoint = "/diagnostics/interface/getRoutes"
+
+// Data structs
+
+type Route struct {
+	Proto                string `json:"proto"`
+	Destination          string `json:"destination"`
+	Gateway              string `json:"gateway"`
+	Flags                string `json:"flags"`
+	Use                  string `json:"use"`
+	MTU                  string `json:"mtu"`
+	Interface            string `json:"netif"`
+	Expire               string `json:"expire"`
+	InterfaceDescription string `json:"intf_description"`
+}
+
+// Operations
+
+func (c *Controller) GetRouteAll(ctx context.Context) ([]Route, error) {
+	var routes []Route
+	if err := c.Rest.Get(ctx, routesEndpoint, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
@@ -0,0 +1,46 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var DHCPOptionOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/addOption",
+	GetEndpoint:         "/dnsmasq/settings/getOption",
+	UpdateEndpoint:      "/dnsmasq/settings/setOption",
+	DeleteEndpoint:      "/dnsmasq/settings/delOption",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "option",
+}
+
+// Data structs
+
+type DHCPOption struct {
+	Type        api.SelectedMap     `json:"type"`
+	Option      api.SelectedMap     `json:"option"`
+	Option6     api.SelectedMap     `json:"option6"`
+	Interface   api.SelectedMap     `json:"interface"`
+	Tags        api.SelectedMapList `json:"tag"`
+	Value       string              `json:"value"`
+	Force       string              `json:"force"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDHCPOption(ctx context.Context, resource *DHCPOption) (string, error) {
+	return api.Add(c.Client(), ctx, DHCPOptionOpts, resource)
+}
+
+func (c *Controller) GetDHCPOption(ctx context.Context, id string) (*DHCPOption, error) {
+	return api.Get(c.Client(), ctx, DHCPOptionOpts, &DHCPOption{}, id)
+}
+
+func (c *Controller) UpdateDHCPOption(ctx context.Context, id string, resource *DHCPOption) error {
+	return api.Update(c.Client(), ctx, DHCPOptionOpts, resource, id)
+}
+
+func (c *Controller) DeleteDHCPOption(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DHCPOptionOpts, id)
+}
@@ -0,0 +1,47 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var DHCPRangeOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/addRange",
+	GetEndpoint:         "/dnsmasq/settings/getRange",
+	UpdateEndpoint:      "/dnsmasq/settings/setRange",
+	DeleteEndpoint:      "/dnsmasq/settings/delRange",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "range",
+}
+
+// Data structs
+
+type DHCPRange struct {
+	Interface    api.SelectedMap `json:"interface"`
+	StartAddress string          `json:"start_addr"`
+	EndAddress   string          `json:"end_addr"`
+	SubnetMask   string          `json:"subnet_mask"`
+	Constructor  api.SelectedMap `json:"constructor"`
+	PrefixLength string          `json:"prefix_len"`
+	LeaseTime    string          `json:"lease_time"`
+	Domain       string          `json:"domain"`
+	Description  string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDHCPRange(ctx context.Context, resource *DHCPRange) (string, error) {
+	return api.Add(c.Client(), ctx, DHCPRangeOpts, resource)
+}
+
+func (c *Controller) GetDHCPRange(ctx context.Context, id string) (*DHCPRange, error) {
+	return api.Get(c.Client(), ctx, DHCPRangeOpts, &DHCPRange{}, id)
+}
+
+func (c *Controller) UpdateDHCPRange(ctx context.Context, id string, resource *DHCPRange) error {
+	return api.Update(c.Client(), ctx, DHCPRangeOpts, resource, id)
+}
+
+func (c *Controller) DeleteDHCPRange(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DHCPRangeOpts, id)
+}
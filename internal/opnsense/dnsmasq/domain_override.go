@@ -0,0 +1,43 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var DomainOverrideOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/addDomain",
+	GetEndpoint:         "/dnsmasq/settings/getDomain",
+	UpdateEndpoint:      "/dnsmasq/settings/setDomain",
+	DeleteEndpoint:      "/dnsmasq/settings/delDomain",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "domainoverride",
+}
+
+// Data structs
+
+type DomainOverride struct {
+	Domain      string `json:"domain"`
+	IP          string `json:"ip"`
+	Port        string `json:"port"`
+	SourceIP    string `json:"srcip"`
+	Description string `json:"descr"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDomainOverride(ctx context.Context, resource *DomainOverride) (string, error) {
+	return api.Add(c.Client(), ctx, DomainOverrideOpts, resource)
+}
+
+func (c *Controller) GetDomainOverride(ctx context.Context, id string) (*DomainOverride, error) {
+	return api.Get(c.Client(), ctx, DomainOverrideOpts, &DomainOverride{}, id)
+}
+
+func (c *Controller) UpdateDomainOverride(ctx context.Context, id string, resource *DomainOverride) error {
+	return api.Update(c.Client(), ctx, DomainOverrideOpts, resource, id)
+}
+
+func (c *Controller) DeleteDomainOverride(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DomainOverrideOpts, id)
+}
@@ -0,0 +1,52 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var SettingsOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/set",
+	GetEndpoint:         "/dnsmasq/settings/get",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "dnsmasq",
+}
+
+// Data structs
+
+// Settings holds the parts of the Dnsmasq model which make up the general DNS and DHCP configuration.
+type Settings struct {
+	Enabled            string              `json:"enable"`
+	Interfaces         api.SelectedMapList `json:"interface"`
+	Port               string              `json:"port"`
+	DNSSEC             string              `json:"dnssec"`
+	DomainNeeded       string              `json:"domain_needed"`
+	NoPrivateReverse   string              `json:"no_private_reverse"`
+	StrictOrder        string              `json:"strict_order"`
+	NoHosts            string              `json:"no_hosts"`
+	LogQueries         string              `json:"log_queries"`
+	RegisterDHCP       string              `json:"regdhcp"`
+	RegisterDHCPStatic string              `json:"regdhcpstatic"`
+	CacheSize          string              `json:"cache_size"`
+	LocalTTL           string              `json:"local_ttl"`
+	DHCP               SettingsDHCP        `json:"dhcp"`
+}
+
+type SettingsDHCP struct {
+	Authoritative        string `json:"authoritative"`
+	DefaultFirewallRules string `json:"default_fw_rules"`
+	FQDN                 string `json:"fqdn"`
+	Domain               string `json:"domain"`
+	LeaseMax             string `json:"lease_max"`
+}
+
+// Operations
+
+func (c *Controller) GetSettings(ctx context.Context) (*Settings, error) {
+	return api.GetFilter(c.Client(), ctx, SettingsOpts, &Settings{}, SettingsOpts.Monad)
+}
+
+func (c *Controller) UpdateSettings(ctx context.Context, resource *Settings) error {
+	_, err := api.Add(c.Client(), ctx, SettingsOpts, resource)
+	return err
+}
@@ -0,0 +1,51 @@
+package dyndns
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var AccountOpts = api.ReqOpts{
+	AddEndpoint:         "/dyndns/accounts/addItem",
+	GetEndpoint:         "/dyndns/accounts/getItem",
+	UpdateEndpoint:      "/dyndns/accounts/setItem",
+	DeleteEndpoint:      "/dyndns/accounts/delItem",
+	ReconfigureEndpoint: dyndnsReconfigureEndpoint,
+	Monad:               "account",
+}
+
+// Data structs
+
+type Account struct {
+	Enabled     string              `json:"enabled"`
+	Service     api.SelectedMap     `json:"service"`
+	Protocol    api.SelectedMap     `json:"protocol"`
+	Server      string              `json:"server"`
+	Username    string              `json:"username"`
+	Password    string              `json:"password"`
+	Hostnames   api.SelectedMapList `json:"hostnames"`
+	Wildcard    string              `json:"wildcard"`
+	Zone        string              `json:"zone"`
+	CheckIP     api.SelectedMap     `json:"checkip"`
+	Interface   api.SelectedMap     `json:"interface"`
+	ForceSSL    string              `json:"force_ssl"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddAccount(ctx context.Context, resource *Account) (string, error) {
+	return api.Add(c.Client(), ctx, AccountOpts, resource)
+}
+
+func (c *Controller) GetAccount(ctx context.Context, id string) (*Account, error) {
+	return api.Get(c.Client(), ctx, AccountOpts, &Account{}, id)
+}
+
+func (c *Controller) UpdateAccount(ctx context.Context, id string, resource *Account) error {
+	return api.Update(c.Client(), ctx, AccountOpts, resource, id)
+}
+
+func (c *Controller) DeleteAccount(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, AccountOpts, id)
+}
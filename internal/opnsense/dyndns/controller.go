@@ -0,0 +1,14 @@
+package dyndns
+
+import "github.com/browningluke/opnsense-go/pkg/api"
+
+const dyndnsReconfigureEndpoint = "/dyndns/service/reconfigure"
+
+// Controller for dyndns (os-ddclient plugin)
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
@@ -0,0 +1,39 @@
+package dyndns
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var SettingsOpts = api.ReqOpts{
+	AddEndpoint:         "/dyndns/settings/set",
+	GetEndpoint:         "/dyndns/settings/get",
+	ReconfigureEndpoint: dyndnsReconfigureEndpoint,
+	Monad:               "ddclient",
+}
+
+// Data structs
+
+// Settings holds the general section of the ddclient model.
+type Settings struct {
+	General SettingsGeneral `json:"general"`
+}
+
+type SettingsGeneral struct {
+	Enabled     string          `json:"enabled"`
+	Verbose     string          `json:"verbose"`
+	AllowIPv6   string          `json:"allowipv6"`
+	DaemonDelay string          `json:"daemon_delay"`
+	Backend     api.SelectedMap `json:"backend"`
+}
+
+// Operations
+
+func (c *Controller) GetSettings(ctx context.Context) (*Settings, error) {
+	return api.GetFilter(c.Client(), ctx, SettingsOpts, &Settings{}, SettingsOpts.Monad)
+}
+
+func (c *Controller) UpdateSettings(ctx context.Context, resource *Settings) error {
+	_, err := api.Add(c.Client(), ctx, SettingsOpts, resource)
+	return err
+}
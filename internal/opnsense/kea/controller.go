@@ -0,0 +1,19 @@
+package kea
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/kea"
+)
+
+const keaReconfigureEndpoint = "/kea/service/reconfigure"
+
+// Controller for kea
+type Controller struct {
+	*kea.Controller
+}
+
+func NewController(a *api.Client) *Controller {
+	return &Controller{
+		Controller: &kea.Controller{Api: a},
+	}
+}
@@ -0,0 +1,101 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var DHCPv4Opts = api.ReqOpts{
+	AddEndpoint:         "/kea/dhcpv4/set",
+	GetEndpoint:         "/kea/dhcpv4/get",
+	ReconfigureEndpoint: keaReconfigureEndpoint,
+	Monad:               "dhcpv4",
+}
+
+var CtrlAgentOpts = api.ReqOpts{
+	AddEndpoint:         "/kea/ctrl_agent/set",
+	GetEndpoint:         "/kea/ctrl_agent/get",
+	ReconfigureEndpoint: keaReconfigureEndpoint,
+	Monad:               "ctrlagent",
+}
+
+// Data structs
+
+// DHCPv4 holds the general and HA sections of the DHCPv4 model. Subnets,
+// reservations and peers are managed as items of their own.
+type DHCPv4 struct {
+	General DHCPv4General `json:"general"`
+	HA      DHCPv4HA      `json:"ha"`
+}
+
+type DHCPv4General struct {
+	Enabled       string              `json:"enabled"`
+	Interfaces    api.SelectedMapList `json:"interfaces"`
+	ValidLifetime string              `json:"valid_lifetime"`
+	FirewallRules string              `json:"fwrules"`
+}
+
+type DHCPv4HA struct {
+	Enabled           string `json:"enabled"`
+	ThisServerName    string `json:"this_server_name"`
+	MaxUnackedClients string `json:"max_unacked_clients"`
+}
+
+// CtrlAgent holds the Kea control agent settings, used by the HA peers to talk to each other.
+type CtrlAgent struct {
+	General CtrlAgentGeneral `json:"general"`
+}
+
+type CtrlAgentGeneral struct {
+	Enabled  string `json:"enabled"`
+	HTTPHost string `json:"http_host"`
+	HTTPPort string `json:"http_port"`
+}
+
+// DHCPv4Settings combines the DHCPv4 service settings with the control agent
+// settings it depends on for HA.
+type DHCPv4Settings struct {
+	DHCPv4    DHCPv4
+	CtrlAgent CtrlAgent
+}
+
+// Operations
+
+func (c *Controller) GetDHCPv4(ctx context.Context) (*DHCPv4, error) {
+	return api.GetFilter(c.Client(), ctx, DHCPv4Opts, &DHCPv4{}, DHCPv4Opts.Monad)
+}
+
+func (c *Controller) UpdateDHCPv4(ctx context.Context, resource *DHCPv4) error {
+	_, err := api.Add(c.Client(), ctx, DHCPv4Opts, resource)
+	return err
+}
+
+func (c *Controller) GetCtrlAgent(ctx context.Context) (*CtrlAgent, error) {
+	return api.GetFilter(c.Client(), ctx, CtrlAgentOpts, &CtrlAgent{}, CtrlAgentOpts.Monad)
+}
+
+func (c *Controller) UpdateCtrlAgent(ctx context.Context, resource *CtrlAgent) error {
+	_, err := api.Add(c.Client(), ctx, CtrlAgentOpts, resource)
+	return err
+}
+
+func (c *Controller) GetDHCPv4Settings(ctx context.Context) (*DHCPv4Settings, error) {
+	dhcpv4, err := c.GetDHCPv4(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctrlAgent, err := c.GetCtrlAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DHCPv4Settings{DHCPv4: *dhcpv4, CtrlAgent: *ctrlAgent}, nil
+}
+
+// UpdateDHCPv4Settings applies the control agent first, so it is available by the
+// time HA is enabled on the DHCPv4 service.
+func (c *Controller) UpdateDHCPv4Settings(ctx context.Context, resource *DHCPv4Settings) error {
+	if err := c.UpdateCtrlAgent(ctx, &resource.CtrlAgent); err != nil {
+		return err
+	}
+	return c.UpdateDHCPv4(ctx, &resource.DHCPv4)
+}
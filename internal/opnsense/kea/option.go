@@ -0,0 +1,154 @@
+package kea
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var OptionOpts = api.ReqOpts{
+	AddEndpoint:         "/kea/dhcpv4/add_option",
+	GetEndpoint:         "/kea/dhcpv4/get_option",
+	UpdateEndpoint:      "/kea/dhcpv4/set_option",
+	DeleteEndpoint:      "/kea/dhcpv4/del_option",
+	ReconfigureEndpoint: keaReconfigureEndpoint,
+	Monad:               "option",
+}
+
+var optionSearchOpts = api.ReqOpts{
+	GetEndpoint: "/kea/dhcpv4/search_option",
+}
+
+// Data structs
+
+// Option is custom DHCPv4 option data. Options without a subnet or reservation apply to the whole server.
+type Option struct {
+	Subnet      api.SelectedMap `json:"subnet"`
+	Reservation api.SelectedMap `json:"reservation"`
+	Code        string          `json:"code"`
+	Space       string          `json:"space"`
+	Type        api.SelectedMap `json:"type"`
+	Data        string          `json:"data"`
+	AlwaysSend  string          `json:"always_send"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddOption(ctx context.Context, resource *Option) (string, error) {
+	return api.Add(c.Client(), ctx, OptionOpts, resource)
+}
+
+func (c *Controller) GetOption(ctx context.Context, id string) (*Option, error) {
+	return api.Get(c.Client(), ctx, OptionOpts, &Option{}, id)
+}
+
+func (c *Controller) UpdateOption(ctx context.Context, id string, resource *Option) error {
+	return api.Update(c.Client(), ctx, OptionOpts, resource, id)
+}
+
+func (c *Controller) DeleteOption(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OptionOpts, id)
+}
+
+// GetOptionAll returns all options, keyed by UUID.
+func (c *Controller) GetOptionAll(ctx context.Context) (map[string]*Option, error) {
+	return search.Rows[Option](c.Client(), ctx, optionSearchOpts)
+}
+
+// GetScopedOptions returns the UUIDs and options belonging to a subnet or reservation, sorted by UUID. Empty
+// subnet and reservation IDs return the global options.
+func (c *Controller) GetScopedOptions(ctx context.Context, subnet string, reservation string) ([]string, []*Option, error) {
+	all, err := c.GetOptionAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ids []string
+	for id, option := range all {
+		if option.Subnet.String() == subnet && option.Reservation.String() == reservation {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	options := make([]*Option, 0, len(ids))
+	for _, id := range ids {
+		options = append(options, all[id])
+	}
+	return ids, options, nil
+}
+
+// SetOwnedOptions makes the options of a subnet or reservation listed in owned match wanted, returning the UUID of
+// the option backing each wanted option. ids holds the UUID already planned for each wanted option, or "" if it has
+// none; those options are updated in place, the others reuse the remaining owned options before new ones are added.
+// Owned options no longer wanted are deleted, and options which are not owned are never changed.
+func (c *Controller) SetOwnedOptions(ctx context.Context, subnet string, reservation string, owned []string, wanted []*Option, ids []string) ([]string, error) {
+	all, err := c.GetOptionAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	available := map[string]bool{}
+	for _, id := range owned {
+		if _, ok := all[id]; ok {
+			available[id] = true
+		}
+	}
+
+	// Options planned with a UUID keep their option
+	result := make([]string, len(wanted))
+	for i, option := range wanted {
+		option.Subnet = api.SelectedMap(subnet)
+		option.Reservation = api.SelectedMap(reservation)
+
+		if i >= len(ids) || !available[ids[i]] {
+			continue
+		}
+		result[i] = ids[i]
+		delete(available, ids[i])
+		if *all[ids[i]] == *option {
+			continue
+		}
+		if err := c.UpdateOption(ctx, ids[i], option); err != nil {
+			return nil, fmt.Errorf("unable to update option: %w", err)
+		}
+	}
+
+	var remaining []string
+	for id := range available {
+		remaining = append(remaining, id)
+	}
+	sort.Strings(remaining)
+
+	// Reuse the remaining owned options before adding new ones
+	for i, option := range wanted {
+		if result[i] != "" {
+			continue
+		}
+		if len(remaining) > 0 {
+			result[i] = remaining[0]
+			remaining = remaining[1:]
+			if err := c.UpdateOption(ctx, result[i], option); err != nil {
+				return nil, fmt.Errorf("unable to update option: %w", err)
+			}
+			continue
+		}
+		id, err := c.AddOption(ctx, option)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create option: %w", err)
+		}
+		result[i] = id
+	}
+
+	// Delete owned options no longer wanted
+	for _, id := range remaining {
+		if err := c.DeleteOption(ctx, id); err != nil {
+			return nil, fmt.Errorf("unable to delete option: %w", err)
+		}
+	}
+
+	return result, nil
+}
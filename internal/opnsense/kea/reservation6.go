@@ -0,0 +1,44 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var Reservation6Opts = api.ReqOpts{
+	AddEndpoint:         "/kea/dhcpv6/add_reservation",
+	GetEndpoint:         "/kea/dhcpv6/get_reservation",
+	UpdateEndpoint:      "/kea/dhcpv6/set_reservation",
+	DeleteEndpoint:      "/kea/dhcpv6/del_reservation",
+	ReconfigureEndpoint: keaReconfigureEndpoint,
+	Monad:               "reservation",
+}
+
+// Data structs
+
+type Reservation6 struct {
+	Subnet       api.SelectedMap     `json:"subnet"`
+	IpAddress    string              `json:"ip_address"`
+	DUID         string              `json:"duid"`
+	Hostname     string              `json:"hostname"`
+	DomainSearch api.SelectedMapList `json:"domain_search"`
+	Description  string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddReservation6(ctx context.Context, resource *Reservation6) (string, error) {
+	return api.Add(c.Client(), ctx, Reservation6Opts, resource)
+}
+
+func (c *Controller) GetReservation6(ctx context.Context, id string) (*Reservation6, error) {
+	return api.Get(c.Client(), ctx, Reservation6Opts, &Reservation6{}, id)
+}
+
+func (c *Controller) UpdateReservation6(ctx context.Context, id string, resource *Reservation6) error {
+	return api.Update(c.Client(), ctx, Reservation6Opts, resource, id)
+}
+
+func (c *Controller) DeleteReservation6(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, Reservation6Opts, id)
+}
@@ -0,0 +1,48 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var Subnet6Opts = api.ReqOpts{
+	AddEndpoint:         "/kea/dhcpv6/add_subnet",
+	GetEndpoint:         "/kea/dhcpv6/get_subnet",
+	UpdateEndpoint:      "/kea/dhcpv6/set_subnet",
+	DeleteEndpoint:      "/kea/dhcpv6/del_subnet",
+	ReconfigureEndpoint: keaReconfigureEndpoint,
+	Monad:               "subnet6",
+}
+
+// Data structs
+
+type OptionData6 struct {
+	DomainNameServers api.SelectedMapList `json:"dns_servers"`
+	DomainSearch      api.SelectedMapList `json:"domain_search"`
+}
+
+type Subnet6 struct {
+	Subnet      string          `json:"subnet"`
+	Interface   api.SelectedMap `json:"interface"`
+	Pools       string          `json:"pools"`
+	OptionData  OptionData6     `json:"option_data"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddSubnet6(ctx context.Context, resource *Subnet6) (string, error) {
+	return api.Add(c.Client(), ctx, Subnet6Opts, resource)
+}
+
+func (c *Controller) GetSubnet6(ctx context.Context, id string) (*Subnet6, error) {
+	return api.Get(c.Client(), ctx, Subnet6Opts, &Subnet6{}, id)
+}
+
+func (c *Controller) UpdateSubnet6(ctx context.Context, id string, resource *Subnet6) error {
+	return api.Update(c.Client(), ctx, Subnet6Opts, resource, id)
+}
+
+func (c *Controller) DeleteSubnet6(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, Subnet6Opts, id)
+}
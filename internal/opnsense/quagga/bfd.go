@@ -0,0 +1,104 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+const bfdPeersEndpoint = "/quagga/diagnostics/bfdpeers"
+
+var BFDOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bfd/set",
+	GetEndpoint:         "/quagga/bfd/get",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "bfd",
+}
+
+var BFDNeighborOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bfd/addNeighbor",
+	GetEndpoint:         "/quagga/bfd/getNeighbor",
+	UpdateEndpoint:      "/quagga/bfd/setNeighbor",
+	DeleteEndpoint:      "/quagga/bfd/delNeighbor",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "neighbor",
+}
+
+var bfdNeighborSearchOpts = api.ReqOpts{
+	GetEndpoint: "/quagga/bfd/searchNeighbor",
+}
+
+// Data structs
+
+type BFD struct {
+	Enabled string `json:"enabled"`
+}
+
+type BFDNeighbor struct {
+	Enabled          string          `json:"enabled"`
+	Description      string          `json:"description"`
+	Address          string          `json:"address"`
+	LocalAddress     string          `json:"localaddress"`
+	Interface        api.SelectedMap `json:"interface"`
+	MultiHop         string          `json:"multihop"`
+	DetectMultiplier string          `json:"detectmultiplier"`
+	ReceiveInterval  string          `json:"receiveinterval"`
+	TransmitInterval string          `json:"transmitinterval"`
+}
+
+// BFDPeer is a session as reported by `show bfd peers json`.
+type BFDPeer struct {
+	Peer             string `json:"peer"`
+	Local            string `json:"local"`
+	Interface        string `json:"interface"`
+	MultiHop         bool   `json:"multihop"`
+	Status           string `json:"status"`
+	Uptime           int64  `json:"uptime"`
+	Diagnostic       string `json:"diagnostic"`
+	RemoteDiagnostic string `json:"remote-diagnostic"`
+	DetectMultiplier int64  `json:"detect-multiplier"`
+	ReceiveInterval  int64  `json:"receive-interval"`
+	TransmitInterval int64  `json:"transmit-interval"`
+}
+
+// Operations
+
+func (c *Controller) GetBFD(ctx context.Context) (*BFD, error) {
+	return api.GetFilter(c.Client(), ctx, BFDOpts, &BFD{}, BFDOpts.Monad)
+}
+
+func (c *Controller) UpdateBFD(ctx context.Context, resource *BFD) error {
+	_, err := api.Add(c.Client(), ctx, BFDOpts, resource)
+	return err
+}
+
+func (c *Controller) GetBFDPeerAll(ctx context.Context) ([]BFDPeer, error) {
+	peers := []BFDPeer{}
+	if err := c.getDiagnostics(ctx, bfdPeersEndpoint, &peers); err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
+
+// CRUD operations
+
+func (c *Controller) AddBFDNeighbor(ctx context.Context, resource *BFDNeighbor) (string, error) {
+	return api.Add(c.Client(), ctx, BFDNeighborOpts, resource)
+}
+
+func (c *Controller) GetBFDNeighbor(ctx context.Context, id string) (*BFDNeighbor, error) {
+	return api.Get(c.Client(), ctx, BFDNeighborOpts, &BFDNeighbor{}, id)
+}
+
+func (c *Controller) UpdateBFDNeighbor(ctx context.Context, id string, resource *BFDNeighbor) error {
+	return api.Update(c.Client(), ctx, BFDNeighborOpts, resource, id)
+}
+
+func (c *Controller) DeleteBFDNeighbor(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, BFDNeighborOpts, id)
+}
+
+// GetBFDNeighborAll returns all BFD neighbors, keyed by UUID.
+func (c *Controller) GetBFDNeighborAll(ctx context.Context) (map[string]*BFDNeighbor, error) {
+	return search.Rows[BFDNeighbor](c.Client(), ctx, bfdNeighborSearchOpts)
+}
@@ -0,0 +1,78 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var BGPOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bgp/set",
+	GetEndpoint:         "/quagga/bgp/get",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "bgp",
+}
+
+var BGPRedistributionOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bgp/addRedistribution",
+	GetEndpoint:         "/quagga/bgp/getRedistribution",
+	UpdateEndpoint:      "/quagga/bgp/setRedistribution",
+	DeleteEndpoint:      "/quagga/bgp/delRedistribution",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "redistribution",
+}
+
+var bgpRedistributionSearchOpts = api.ReqOpts{
+	GetEndpoint: "/quagga/bgp/searchRedistribution",
+}
+
+// Data structs
+
+type BGP struct {
+	Enabled            string              `json:"enabled"`
+	ASNumber           string              `json:"asnumber"`
+	RouterID           string              `json:"routerid"`
+	Networks           api.SelectedMapList `json:"networks"`
+	Graceful           string              `json:"graceful"`
+	LogNeighborChanges string              `json:"logneighborchanges"`
+	NetworkImportCheck string              `json:"networkimportcheck"`
+}
+
+type BGPRedistribution struct {
+	Enabled      string          `json:"enabled"`
+	Description  string          `json:"description"`
+	Redistribute api.SelectedMap `json:"redistribute"`
+	RouteMap     api.SelectedMap `json:"linkedRouteMap"`
+}
+
+// Operations
+
+func (c *Controller) GetBGP(ctx context.Context) (*BGP, error) {
+	return api.GetFilter(c.Client(), ctx, BGPOpts, &BGP{}, BGPOpts.Monad)
+}
+
+func (c *Controller) UpdateBGP(ctx context.Context, resource *BGP) error {
+	_, err := api.Add(c.Client(), ctx, BGPOpts, resource)
+	return err
+}
+
+func (c *Controller) AddBGPRedistribution(ctx context.Context, resource *BGPRedistribution) (string, error) {
+	return api.Add(c.Client(), ctx, BGPRedistributionOpts, resource)
+}
+
+func (c *Controller) GetBGPRedistribution(ctx context.Context, id string) (*BGPRedistribution, error) {
+	return api.Get(c.Client(), ctx, BGPRedistributionOpts, &BGPRedistribution{}, id)
+}
+
+func (c *Controller) UpdateBGPRedistribution(ctx context.Context, id string, resource *BGPRedistribution) error {
+	return api.Update(c.Client(), ctx, BGPRedistributionOpts, resource, id)
+}
+
+func (c *Controller) DeleteBGPRedistribution(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, BGPRedistributionOpts, id)
+}
+
+// GetBGPRedistributionAll returns all redistribution entries, keyed by UUID.
+func (c *Controller) GetBGPRedistributionAll(ctx context.Context) (map[string]*BGPRedistribution, error) {
+	return search.Rows[BGPRedistribution](c.Client(), ctx, bgpRedistributionSearchOpts)
+}
@@ -0,0 +1,17 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var bgpASPathSearchOpts = api.ReqOpts{
+	GetEndpoint: "/quagga/bgp/searchAspath",
+}
+
+// GetBGPASPathAll returns all AS path entries, keyed by UUID.
+func (c *Controller) GetBGPASPathAll(ctx context.Context) (map[string]*quagga.BGPASPath, error) {
+	return search.Rows[quagga.BGPASPath](c.Client(), ctx, bgpASPathSearchOpts)
+}
@@ -0,0 +1,17 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var bgpCommunityListSearchOpts = api.ReqOpts{
+	GetEndpoint: "/quagga/bgp/searchCommunitylist",
+}
+
+// GetBGPCommunityListAll returns all community list entries, keyed by UUID.
+func (c *Controller) GetBGPCommunityListAll(ctx context.Context) (map[string]*quagga.BGPCommunityList, error) {
+	return search.Rows[quagga.BGPCommunityList](c.Client(), ctx, bgpCommunityListSearchOpts)
+}
@@ -0,0 +1,50 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var bgpNeighborSearchOpts = api.ReqOpts{
+	GetEndpoint: "/quagga/bgp/searchNeighbor",
+}
+
+// Data structs
+
+// BGPNeighbor extends the upstream neighbor with peer group and address family settings.
+type BGPNeighbor struct {
+	quagga.BGPNeighbor
+
+	PeerGroup                  api.SelectedMap `json:"linkedPeergroup"`
+	IPv4Unicast                string          `json:"ipv4unicast"`
+	IPv6Unicast                string          `json:"ipv6unicast"`
+	AllowASIn                  string          `json:"allowasin"`
+	SoftReconfigurationInbound string          `json:"softreconfigurationinbound"`
+	MaximumPrefix              string          `json:"maximumprefix"`
+	LocalAS                    string          `json:"localas"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddBGPNeighbor(ctx context.Context, resource *BGPNeighbor) (string, error) {
+	return api.Add(c.Client(), ctx, quagga.BGPNeighborOpts, resource)
+}
+
+func (c *Controller) GetBGPNeighbor(ctx context.Context, id string) (*BGPNeighbor, error) {
+	return api.Get(c.Client(), ctx, quagga.BGPNeighborOpts, &BGPNeighbor{}, id)
+}
+
+func (c *Controller) UpdateBGPNeighbor(ctx context.Context, id string, resource *BGPNeighbor) error {
+	return api.Update(c.Client(), ctx, quagga.BGPNeighborOpts, resource, id)
+}
+
+func (c *Controller) DeleteBGPNeighbor(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, quagga.BGPNeighborOpts, id)
+}
+
+// GetBGPNeighborAll returns all BGP neighbors, keyed by UUID.
+func (c *Controller) GetBGPNeighborAll(ctx context.Context) (map[string]*BGPNeighbor, error) {
+	return search.Rows[BGPNeighbor](c.Client(), ctx, bgpNeighborSearchOpts)
+}
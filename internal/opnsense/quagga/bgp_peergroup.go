@@ -0,0 +1,51 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var BGPPeerGroupOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/bgp/addPeergroup",
+	GetEndpoint:         "/quagga/bgp/getPeergroup",
+	UpdateEndpoint:      "/quagga/bgp/setPeergroup",
+	DeleteEndpoint:      "/quagga/bgp/delPeergroup",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "peergroup",
+}
+
+// Data structs
+
+type BGPPeerGroup struct {
+	Enabled       string          `json:"enabled"`
+	Description   string          `json:"description"`
+	Name          string          `json:"name"`
+	RemoteAS      string          `json:"remoteas"`
+	UpdateSource  api.SelectedMap `json:"updatesource"`
+	NextHopSelf   string          `json:"nexthopself"`
+	KeepAlive     string          `json:"keepalive"`
+	HoldDown      string          `json:"holddown"`
+	ConnectTimer  string          `json:"connecttimer"`
+	PrefixListIn  api.SelectedMap `json:"linkedPrefixlistIn"`
+	PrefixListOut api.SelectedMap `json:"linkedPrefixlistOut"`
+	RouteMapIn    api.SelectedMap `json:"linkedRoutemapIn"`
+	RouteMapOut   api.SelectedMap `json:"linkedRoutemapOut"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddBGPPeerGroup(ctx context.Context, resource *BGPPeerGroup) (string, error) {
+	return api.Add(c.Client(), ctx, BGPPeerGroupOpts, resource)
+}
+
+func (c *Controller) GetBGPPeerGroup(ctx context.Context, id string) (*BGPPeerGroup, error) {
+	return api.Get(c.Client(), ctx, BGPPeerGroupOpts, &BGPPeerGroup{}, id)
+}
+
+func (c *Controller) UpdateBGPPeerGroup(ctx context.Context, id string, resource *BGPPeerGroup) error {
+	return api.Update(c.Client(), ctx, BGPPeerGroupOpts, resource, id)
+}
+
+func (c *Controller) DeleteBGPPeerGroup(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, BGPPeerGroupOpts, id)
+}
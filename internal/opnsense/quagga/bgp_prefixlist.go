@@ -0,0 +1,17 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var bgpPrefixListSearchOpts = api.ReqOpts{
+	GetEndpoint: "/quagga/bgp/searchPrefixlist",
+}
+
+// GetBGPPrefixListAll returns all prefix list entries, keyed by UUID.
+func (c *Controller) GetBGPPrefixListAll(ctx context.Context) (map[string]*quagga.BGPPrefixList, error) {
+	return search.Rows[quagga.BGPPrefixList](c.Client(), ctx, bgpPrefixListSearchOpts)
+}
@@ -0,0 +1,22 @@
+package quagga
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"terraform-provider-opnsense/internal/opnsense/rest"
+)
+
+const quaggaReconfigureEndpoint = "/quagga/service/reconfigure"
+
+// Controller for quagga
+type Controller struct {
+	*quagga.Controller
+	Rest *rest.Client
+}
+
+func NewController(a *api.Client, r *rest.Client) *Controller {
+	return &Controller{
+		Controller: &quagga.Controller{Api: a},
+		Rest:       r,
+	}
+}
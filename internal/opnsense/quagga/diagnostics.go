@@ -0,0 +1,81 @@
+package quagga
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const (
+	bgpSummaryEndpoint   = "/quagga/diagnostics/bgpsummary"
+	ospfNeighborEndpoint = "/quagga/diagnostics/ospfneighbor"
+)
+
+// Data structs
+
+// BGPSummary is the output of `show bgp summary json`, keyed by address family (e.g. `ipv4Unicast`).
+type BGPSummary map[string]BGPSummaryAddressFamily
+
+type BGPSummaryAddressFamily struct {
+	RouterID string                    `json:"routerId"`
+	AS       int64                     `json:"as"`
+	Peers    map[string]BGPSummaryPeer `json:"peers"`
+}
+
+type BGPSummaryPeer struct {
+	RemoteAS         int64  `json:"remoteAs"`
+	State            string `json:"state"`
+	Uptime           string `json:"peerUptime"`
+	UptimeMsec       int64  `json:"peerUptimeMsec"`
+	PrefixesReceived int64  `json:"pfxRcd"`
+	PrefixesSent     int64  `json:"pfxSnt"`
+}
+
+// OSPFNeighbors is the output of `show ip ospf neighbor json`, keyed by neighbor router ID.
+type OSPFNeighbors map[string][]OSPFNeighbor
+
+type OSPFNeighbor struct {
+	Priority      int64  `json:"priority"`
+	State         string `json:"nbrState"`
+	Address       string `json:"ifaceAddress"`
+	InterfaceName string `json:"ifaceName"`
+	DeadTimeMsec  int64  `json:"routerDeadIntervalTimerDueMsec"`
+}
+
+type diagnosticsResponse struct {
+	Response json.RawMessage `json:"response"`
+}
+
+// getDiagnostics unmarshals the response of a diagnostics endpoint into resp. When
+// the routing daemon is not running, OPNsense returns an empty array instead of an
+// object, which is treated as an empty response.
+func (c *Controller) getDiagnostics(ctx context.Context, endpoint string, resp any) error {
+	var d diagnosticsResponse
+	if err := c.Rest.Get(ctx, endpoint, &d); err != nil {
+		return err
+	}
+
+	if len(d.Response) == 0 || string(d.Response) == "[]" || string(d.Response) == "null" {
+		return nil
+	}
+	return json.Unmarshal(d.Response, resp)
+}
+
+// Operations
+
+func (c *Controller) GetBGPSummary(ctx context.Context) (BGPSummary, error) {
+	summary := BGPSummary{}
+	if err := c.getDiagnostics(ctx, bgpSummaryEndpoint, &summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func (c *Controller) GetOSPFNeighbors(ctx context.Context) (OSPFNeighbors, error) {
+	var resp struct {
+		Neighbors OSPFNeighbors `json:"neighbors"`
+	}
+	if err := c.getDiagnostics(ctx, ospfNeighborEndpoint, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Neighbors, nil
+}
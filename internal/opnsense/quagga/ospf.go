@@ -0,0 +1,111 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var OSPFOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospfsettings/set",
+	GetEndpoint:         "/quagga/ospfsettings/get",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "ospf",
+}
+
+var OSPFNetworkOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospfsettings/addNetwork",
+	GetEndpoint:         "/quagga/ospfsettings/getNetwork",
+	UpdateEndpoint:      "/quagga/ospfsettings/setNetwork",
+	DeleteEndpoint:      "/quagga/ospfsettings/delNetwork",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "network",
+}
+
+var OSPFAreaOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospfsettings/addArea",
+	GetEndpoint:         "/quagga/ospfsettings/getArea",
+	UpdateEndpoint:      "/quagga/ospfsettings/setArea",
+	DeleteEndpoint:      "/quagga/ospfsettings/delArea",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "area",
+}
+
+// Data structs
+
+type OSPF struct {
+	Enabled            string              `json:"enabled"`
+	RouterID           string              `json:"routerid"`
+	CostReference      string              `json:"costreference"`
+	PassiveInterfaces  api.SelectedMapList `json:"passiveinterfaces"`
+	Redistribute       api.SelectedMapList `json:"redistribute"`
+	RedistributeMap    api.SelectedMap     `json:"redistributemap"`
+	Originate          string              `json:"originate"`
+	OriginateAlways    string              `json:"originatealways"`
+	OriginateMetric    string              `json:"originatemetric"`
+	SPFDelay           string              `json:"spf_delay"`
+	SPFInitialHoldTime string              `json:"spf_initial_holdtime"`
+	SPFMaximumHoldTime string              `json:"spf_maximum_holdtime"`
+}
+
+type OSPFNetwork struct {
+	Enabled       string          `json:"enabled"`
+	IPAddr        string          `json:"ipaddr"`
+	NetMask       string          `json:"netmask"`
+	Area          string          `json:"area"`
+	AreaRange     string          `json:"arearange"`
+	PrefixListIn  api.SelectedMap `json:"linkedPrefixlistIn"`
+	PrefixListOut api.SelectedMap `json:"linkedPrefixlistOut"`
+}
+
+type OSPFArea struct {
+	Enabled     string          `json:"enabled"`
+	Area        string          `json:"area"`
+	Type        api.SelectedMap `json:"type"`
+	NoSummary   string          `json:"nosummary"`
+	Description string          `json:"description"`
+}
+
+// Operations
+
+func (c *Controller) GetOSPF(ctx context.Context) (*OSPF, error) {
+	return api.GetFilter(c.Client(), ctx, OSPFOpts, &OSPF{}, OSPFOpts.Monad)
+}
+
+func (c *Controller) UpdateOSPF(ctx context.Context, resource *OSPF) error {
+	_, err := api.Add(c.Client(), ctx, OSPFOpts, resource)
+	return err
+}
+
+// CRUD operations
+
+func (c *Controller) AddOSPFNetwork(ctx context.Context, resource *OSPFNetwork) (string, error) {
+	return api.Add(c.Client(), ctx, OSPFNetworkOpts, resource)
+}
+
+func (c *Controller) GetOSPFNetwork(ctx context.Context, id string) (*OSPFNetwork, error) {
+	return api.Get(c.Client(), ctx, OSPFNetworkOpts, &OSPFNetwork{}, id)
+}
+
+func (c *Controller) UpdateOSPFNetwork(ctx context.Context, id string, resource *OSPFNetwork) error {
+	return api.Update(c.Client(), ctx, OSPFNetworkOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPFNetwork(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPFNetworkOpts, id)
+}
+
+func (c *Controller) AddOSPFArea(ctx context.Context, resource *OSPFArea) (string, error) {
+	return api.Add(c.Client(), ctx, OSPFAreaOpts, resource)
+}
+
+func (c *Controller) GetOSPFArea(ctx context.Context, id string) (*OSPFArea, error) {
+	return api.Get(c.Client(), ctx, OSPFAreaOpts, &OSPFArea{}, id)
+}
+
+func (c *Controller) UpdateOSPFArea(ctx context.Context, id string, resource *OSPFArea) error {
+	return api.Update(c.Client(), ctx, OSPFAreaOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPFArea(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPFAreaOpts, id)
+}
@@ -0,0 +1,73 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var OSPF6Opts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospf6settings/set",
+	GetEndpoint:         "/quagga/ospf6settings/get",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "ospf6",
+}
+
+var OSPF6InterfaceOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/ospf6settings/addInterface",
+	GetEndpoint:         "/quagga/ospf6settings/getInterface",
+	UpdateEndpoint:      "/quagga/ospf6settings/setInterface",
+	DeleteEndpoint:      "/quagga/ospf6settings/delInterface",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "interface",
+}
+
+// Data structs
+
+type OSPF6 struct {
+	Enabled      string              `json:"enabled"`
+	RouterID     string              `json:"routerid"`
+	Redistribute api.SelectedMapList `json:"redistribute"`
+}
+
+type OSPF6Interface struct {
+	Enabled            string          `json:"enabled"`
+	InterfaceName      api.SelectedMap `json:"interfacename"`
+	Area               string          `json:"area"`
+	Cost               string          `json:"cost"`
+	HelloInterval      string          `json:"hellointerval"`
+	DeadInterval       string          `json:"deadinterval"`
+	RetransmitInterval string          `json:"retransmitinterval"`
+	TransmitDelay      string          `json:"transmitdelay"`
+	Priority           string          `json:"priority"`
+	NetworkType        api.SelectedMap `json:"networktype"`
+	Passive            string          `json:"passive"`
+}
+
+// Operations
+
+func (c *Controller) GetOSPF6(ctx context.Context) (*OSPF6, error) {
+	return api.GetFilter(c.Client(), ctx, OSPF6Opts, &OSPF6{}, OSPF6Opts.Monad)
+}
+
+func (c *Controller) UpdateOSPF6(ctx context.Context, resource *OSPF6) error {
+	_, err := api.Add(c.Client(), ctx, OSPF6Opts, resource)
+	return err
+}
+
+// CRUD operations
+
+func (c *Controller) AddOSPF6Interface(ctx context.Context, resource *OSPF6Interface) (string, error) {
+	return api.Add(c.Client(), ctx, OSPF6InterfaceOpts, resource)
+}
+
+func (c *Controller) GetOSPF6Interface(ctx context.Context, id string) (*OSPF6Interface, error) {
+	return api.Get(c.Client(), ctx, OSPF6InterfaceOpts, &OSPF6Interface{}, id)
+}
+
+func (c *Controller) UpdateOSPF6Interface(ctx context.Context, id string, resource *OSPF6Interface) error {
+	return api.Update(c.Client(), ctx, OSPF6InterfaceOpts, resource, id)
+}
+
+func (c *Controller) DeleteOSPF6Interface(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, OSPF6InterfaceOpts, id)
+}
@@ -0,0 +1,35 @@
+package quagga
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var RIPOpts = api.ReqOpts{
+	AddEndpoint:         "/quagga/rip/set",
+	GetEndpoint:         "/quagga/rip/get",
+	ReconfigureEndpoint: quaggaReconfigureEndpoint,
+	Monad:               "rip",
+}
+
+// Data structs
+
+type RIP struct {
+	Enabled           string              `json:"enabled"`
+	Version           api.SelectedMap     `json:"version"`
+	Networks          api.SelectedMapList `json:"networks"`
+	PassiveInterfaces api.SelectedMapList `json:"passiveinterfaces"`
+	Redistribute      api.SelectedMapList `json:"redistribute"`
+	DefaultMetric     string              `json:"defaultmetric"`
+}
+
+// Operations
+
+func (c *Controller) GetRIP(ctx context.Context) (*RIP, error) {
+	return api.GetFilter(c.Client(), ctx, RIPOpts, &RIP{}, RIPOpts.Monad)
+}
+
+func (c *Controller) UpdateRIP(ctx context.Context, resource *RIP) error {
+	_, err := api.Add(c.Client(), ctx, RIPOpts, resource)
+	return err
+}
@@ -0,0 +1,176 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Retry defaults, matching the opnsense-go API client.
+const (
+	clientMaxBackoff = 30 * time.Second
+	clientMinBackoff = 1 * time.Second
+	clientMaxRetries = 4
+)
+
+// mutexKey is the api.GlobalMutexKV key opnsense-go holds while changing config (clientMutexKey in
+// its api package), so POST requests sent through this client are serialized with its writes.
+const mutexKey = "OPNSENSE"
+
+// Client performs requests against OPNsense API endpoints whose responses do not
+// fit the CRUD helpers in opnsense-go (e.g. endpoints returning a top-level JSON array).
+type Client struct {
+	client *retryablehttp.Client
+	opts   api.Options
+}
+
+func NewClient(options api.Options) *Client {
+	client := &Client{
+		client: retryablehttp.NewClient(),
+		opts:   options,
+	}
+
+	// Configure HTTP client
+	client.client.HTTPClient.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: options.AllowInsecure},
+	}
+	client.client.Logger = nil
+
+	// Set defaults for retries
+	client.client.RetryWaitMax = clientMaxBackoff
+	client.client.RetryWaitMin = clientMinBackoff
+	client.client.RetryMax = clientMaxRetries
+
+	// Override defaults for retries, if set
+	if options.MaxBackoff != 0 {
+		client.client.RetryWaitMax = time.Duration(options.MaxBackoff) * time.Second
+	}
+	if options.MinBackoff != 0 {
+		client.client.RetryWaitMin = time.Duration(options.MinBackoff) * time.Second
+	}
+	if options.MaxRetries != 0 {
+		client.client.RetryMax = int(options.MaxRetries)
+	}
+
+	return client
+}
+
+func (c *Client) getAuth() string {
+	auth := c.opts.APIKey + ":" + c.opts.APISecret
+	return base64.StdEncoding.EncodeToString([]byte(auth))
+}
+
+func (c *Client) url(endpoint string) string {
+	return fmt.Sprintf("%s/api%s", c.opts.Uri, endpoint)
+}
+
+// Get sends a GET request to the endpoint and unmarshals the JSON response into resp.
+// Failed requests are retried with the retry settings of the provider.
+func (c *Client) Get(ctx context.Context, endpoint string, resp any) error {
+	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", c.url(endpoint), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", c.getAuth()))
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return decode(res, resp)
+}
+
+// Post sends body as JSON in a POST request to the endpoint and unmarshals the JSON response into resp.
+// The request is sent while holding the same global lock opnsense-go uses for config changes.
+func (c *Client) Post(ctx context.Context, endpoint string, body any, resp any) error {
+	api.GlobalMutexKV.Lock(mutexKey, ctx)
+	defer api.GlobalMutexKV.Unlock(mutexKey, ctx)
+
+	return c.post(ctx, endpoint, body, resp)
+}
+
+// post sends a POST request exactly once. Unlike GET requests, POSTs may change state on the
+// firewall, so they are never retried.
+func (c *Client) post(ctx context.Context, endpoint string, body any, resp any) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.url(endpoint), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", c.getAuth()))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := c.client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return decode(res, resp)
+}
+
+// PostAndGet sends body to postEndpoint and unmarshals its response into postResp, then checks
+// it with validate before reading getEndpoint into getResp. The global lock is held across both
+// requests, for endpoints that store a request with one call and run it with another, so
+// concurrent callers cannot overwrite each other's request.
+func (c *Client) PostAndGet(ctx context.Context, postEndpoint string, body any, postResp any, validate func() error, getEndpoint string, getResp any) error {
+	api.GlobalMutexKV.Lock(mutexKey, ctx)
+	defer api.GlobalMutexKV.Unlock(mutexKey, ctx)
+
+	if err := c.post(ctx, postEndpoint, body, postResp); err != nil {
+		return err
+	}
+	if validate != nil {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return c.Get(ctx, getEndpoint, getResp)
+}
+
+// decode checks the status of the response and unmarshals its JSON body into resp.
+func decode(res *http.Response, resp any) error {
+	defer res.Body.Close()
+
+	if err := checkStatus(res); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+// checkStatus converts a non-200 response into the error types used by opnsense-go.
+func checkStatus(res *http.Response) error {
+	switch res.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return errs.NewNotFoundError()
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &errs.Error{
+			Type:         errs.ErrorTypeAuthorization,
+			StatusCode:   res.StatusCode,
+			ErrorMessage: "request was not authorized",
+		}
+	default:
+		return &errs.Error{
+			Type:         errs.ErrorTypeRequest,
+			StatusCode:   res.StatusCode,
+			ErrorMessage: fmt.Sprintf("status code non-200; status code %d", res.StatusCode),
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/routes"
+	"terraform-provider-opnsense/internal/opnsense/rest"
+)
+
+// Controller for routes
+type Controller struct {
+	*routes.Controller
+	Rest *rest.Client
+}
+
+func NewController(a *api.Client, r *rest.Client) *Controller {
+	return &Controller{
+		Controller: &routes.Controller{Api: a},
+		Rest:       r,
+	}
+}
@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+)
+
+var GatewayOpts = api.ReqOpts{
+	GetEndpoint: "/routing/settings/searchGateway",
+}
+
+var GatewayStatusOpts = api.ReqOpts{
+	GetEndpoint: "/routes/gateway/status",
+}
+
+// Data structs
+
+type Gateway struct {
+	Name       string `json:"name"`
+	Interface  string `json:"interface"`
+	IPProtocol string `json:"ipprotocol"`
+}
+
+type GatewayStatus struct {
+	Name             string `json:"name"`
+	Address          string `json:"address"`
+	Status           string `json:"status"`
+	StatusTranslated string `json:"status_translated"`
+	Loss             string `json:"loss"`
+	Delay            string `json:"delay"`
+	StdDev           string `json:"stddev"`
+	Monitor          string `json:"monitor"`
+}
+
+// Operations
+
+func (c *Controller) GetGatewayAll(ctx context.Context) ([]Gateway, error) {
+	var rows []Gateway
+	_, err := api.GetFilter(c.Client(), ctx, GatewayOpts, &rows, "rows")
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (c *Controller) GetGatewayByName(ctx context.Context, name string) (*Gateway, error) {
+	gateways, err := c.GetGatewayAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gw := range gateways {
+		if gw.Name == name {
+			return &gw, nil
+		}
+	}
+
+	return nil, errs.NewNotFoundError()
+}
+
+func (c *Controller) GetGatewayStatusAll(ctx context.Context) ([]GatewayStatus, error) {
+	var items []GatewayStatus
+	_, err := api.GetFilter(c.Client(), ctx, GatewayStatusOpts, &items, "items")
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
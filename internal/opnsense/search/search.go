@@ -0,0 +1,116 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var (
+	selectedMapType       = reflect.TypeOf(api.SelectedMap(""))
+	selectedMapListType   = reflect.TypeOf(api.SelectedMapList{})
+	selectedMapListNLType = reflect.TypeOf(api.SelectedMapListNL{})
+)
+
+// Rows returns every row of a search endpoint decoded into T, keyed by UUID.
+//
+// Search endpoints return the raw value of option fields as a plain string, while T uses the
+// selected-map types the get endpoints need. Those fields are rewritten into selected-map form
+// before decoding, so the structs used for single items can be reused as is.
+func Rows[T any](c *api.Client, ctx context.Context, opts api.ReqOpts) (map[string]*T, error) {
+	var rows []map[string]json.RawMessage
+	if _, err := api.GetFilter(c, ctx, opts, &rows, "rows"); err != nil {
+		return nil, err
+	}
+
+	separators := map[string]string{}
+	selectedFields(reflect.TypeOf((*T)(nil)).Elem(), separators)
+
+	items := map[string]*T{}
+	for _, row := range rows {
+		var id string
+		if err := json.Unmarshal(row["uuid"], &id); err != nil || id == "" {
+			return nil, fmt.Errorf("unable to decode search row: missing uuid")
+		}
+
+		for name, sep := range separators {
+			value, ok := row[name]
+			if !ok {
+				continue
+			}
+			if converted, ok := toSelectedMap(value, sep); ok {
+				row[name] = converted
+			}
+		}
+
+		data, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		item := new(T)
+		if err := json.Unmarshal(data, item); err != nil {
+			return nil, fmt.Errorf("unable to decode search row %s: %w", id, err)
+		}
+		items[id] = item
+	}
+
+	return items, nil
+}
+
+// selectedFields records the JSON name of every selected-map field of t, including those of
+// embedded structs, along with the separator used between multiple selected keys.
+func selectedFields(t reflect.Type, separators map[string]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			selectedFields(field.Type, separators)
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		switch field.Type {
+		case selectedMapType:
+			separators[name] = ""
+		case selectedMapListType:
+			separators[name] = ","
+		case selectedMapListNLType:
+			separators[name] = "\n"
+		}
+	}
+}
+
+// toSelectedMap converts a raw string value into selected-map JSON. Values which are not
+// strings are assumed to be in selected-map form already.
+func toSelectedMap(value json.RawMessage, sep string) (json.RawMessage, bool) {
+	var raw string
+	if err := json.Unmarshal(value, &raw); err != nil {
+		return nil, false
+	}
+
+	keys := []string{raw}
+	if sep != "" {
+		keys = strings.Split(raw, sep)
+	}
+
+	selected := map[string]map[string]any{}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		selected[key] = map[string]any{"value": key, "selected": 1}
+	}
+
+	data, err := json.Marshal(selected)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,22 @@
+package unbound
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"terraform-provider-opnsense/internal/opnsense/rest"
+)
+
+const unboundReconfigureEndpoint = "/unbound/service/reconfigure"
+
+// Controller for unbound
+type Controller struct {
+	*unbound.Controller
+	Rest *rest.Client
+}
+
+func NewController(a *api.Client, r *rest.Client) *Controller {
+	return &Controller{
+		Controller: &unbound.Controller{Api: a},
+		Rest:       r,
+	}
+}
@@ -0,0 +1,149 @@
+package unbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	statsEndpoint   = "/unbound/diagnostics/stats"
+	restartEndpoint = "/unbound/service/restart"
+
+	dnsLookupSetEndpoint  = "/diagnostics/dns_diagnostics/set"
+	dnsLookupExecEndpoint = "/diagnostics/dns_diagnostics/exec"
+)
+
+// Data structs
+
+// Stats holds the counters reported by `unbound-control stats_noreset`, keyed by their dotted name
+// (e.g. `total.num.queries` or `num.query.type.A`).
+type Stats map[string]string
+
+// Value returns the counter with the given name, or an empty string if it is not reported.
+func (s Stats) Value(name string) string {
+	return s[name]
+}
+
+// Prefixed returns all counters below the given prefix, keyed by the remainder of their name.
+func (s Stats) Prefixed(prefix string) map[string]string {
+	values := map[string]string{}
+	for name, value := range s {
+		if rest, ok := strings.CutPrefix(name, prefix+"."); ok {
+			values[rest] = value
+		}
+	}
+	return values
+}
+
+// DNSLookup is the result of a DNS lookup, holding the answers keyed by record type (e.g. `A` or `MX`).
+type DNSLookup map[string][]string
+
+type statsResponse struct {
+	Status string         `json:"status"`
+	Data   map[string]any `json:"data"`
+}
+
+type dnsLookupSettings struct {
+	Hostname string `json:"hostname"`
+	Server   string `json:"server"`
+}
+
+type dnsLookupResponse struct {
+	Response json.RawMessage `json:"response"`
+}
+
+type statusResponse struct {
+	Status string `json:"status"`
+	Result string `json:"result"`
+}
+
+// serviceResponse is returned by the service actions, with the output of configd.
+type serviceResponse struct {
+	Response string `json:"response"`
+}
+
+// flattenStats turns the nested counters returned by OPNsense back into their dotted names.
+func flattenStats(prefix string, data map[string]any, stats Stats) {
+	for key, value := range data {
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case map[string]any:
+			flattenStats(name, v, stats)
+		case nil:
+			stats[name] = ""
+		case float64:
+			stats[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			stats[name] = fmt.Sprint(v)
+		}
+	}
+}
+
+// Operations
+
+func (c *Controller) GetStats(ctx context.Context) (Stats, error) {
+	var resp statsResponse
+	if err := c.Rest.Get(ctx, statsEndpoint, &resp); err != nil {
+		return nil, err
+	}
+
+	// Unbound does not report statistics while it is stopped
+	if resp.Status != "ok" {
+		return nil, fmt.Errorf("unable to read unbound statistics, status: %q", resp.Status)
+	}
+
+	stats := Stats{}
+	flattenStats("", resp.Data, stats)
+	return stats, nil
+}
+
+// LookupDNS resolves the hostname against the given server, using the DNS lookup diagnostics of OPNsense.
+// The parameters are stored by one request and used by the next, so both are sent under the global lock.
+func (c *Controller) LookupDNS(ctx context.Context, hostname string, server string) (DNSLookup, error) {
+	var setResp statusResponse
+	var resp dnsLookupResponse
+	err := c.Rest.PostAndGet(ctx, dnsLookupSetEndpoint, map[string]any{
+		"dns": map[string]any{
+			"settings": dnsLookupSettings{Hostname: hostname, Server: server},
+		},
+	}, &setResp, func() error {
+		if setResp.Result != "saved" {
+			return fmt.Errorf("unable to set dns lookup parameters, result: %q", setResp.Result)
+		}
+		return nil
+	}, dnsLookupExecEndpoint, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// A name without any answers is returned as an empty array instead of an object
+	lookup := DNSLookup{}
+	if len(resp.Response) == 0 || string(resp.Response) == "[]" || string(resp.Response) == "null" {
+		return lookup, nil
+	}
+	if err := json.Unmarshal(resp.Response, &lookup); err != nil {
+		return nil, err
+	}
+	return lookup, nil
+}
+
+// FlushCache clears the Unbound cache. OPNsense has no API action to flush a single domain, so
+// this restarts Unbound through the service controller, which starts it with an empty cache.
+func (c *Controller) FlushCache(ctx context.Context) error {
+	var resp serviceResponse
+	if err := c.Rest.Post(ctx, restartEndpoint, map[string]string{}, &resp); err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(resp.Response), "ok") {
+		return fmt.Errorf("unable to restart unbound, response: %q", strings.TrimSpace(resp.Response))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+// DNSBLOpts uses the DNSBL action instead of the Unbound reconfigure, as only the
+// former downloads the configured lists and regenerates the blocklist.
+var DNSBLOpts = api.ReqOpts{
+	AddEndpoint:         "/unbound/settings/addDnsbl",
+	GetEndpoint:         "/unbound/settings/getDnsbl",
+	UpdateEndpoint:      "/unbound/settings/setDnsbl",
+	DeleteEndpoint:      "/unbound/settings/delDnsbl",
+	ReconfigureEndpoint: "/unbound/service/dnsbl",
+	Monad:               "blocklist",
+}
+
+// Data structs
+
+type DNSBL struct {
+	Enabled     string              `json:"enabled"`
+	Description string              `json:"description"`
+	Type        api.SelectedMapList `json:"type"`
+	Lists       api.SelectedMapList `json:"lists"`
+	AllowLists  api.SelectedMapList `json:"allowlists"`
+	BlockLists  api.SelectedMapList `json:"blocklists"`
+	Wildcards   api.SelectedMapList `json:"wildcards"`
+	SourceNets  api.SelectedMapList `json:"source_nets"`
+	Address     string              `json:"address"`
+	NXDomain    string              `json:"nxdomain"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDNSBL(ctx context.Context, resource *DNSBL) (string, error) {
+	return api.Add(c.Client(), ctx, DNSBLOpts, resource)
+}
+
+func (c *Controller) GetDNSBL(ctx context.Context, id string) (*DNSBL, error) {
+	return api.Get(c.Client(), ctx, DNSBLOpts, &DNSBL{}, id)
+}
+
+func (c *Controller) UpdateDNSBL(ctx context.Context, id string, resource *DNSBL) error {
+	return api.Update(c.Client(), ctx, DNSBLOpts, resource, id)
+}
+
+func (c *Controller) DeleteDNSBL(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DNSBLOpts, id)
+}
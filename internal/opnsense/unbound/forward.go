@@ -0,0 +1,75 @@
+package unbound
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+)
+
+// forwardModelOpts reads the complete Unbound model, which lists the forwards in the order they are configured.
+var forwardModelOpts = api.ReqOpts{
+	GetEndpoint: "/unbound/settings/get",
+	Monad:       "unbound",
+}
+
+// Data structs
+
+type forwardModel struct {
+	Dots struct {
+		Dot orderedForwards `json:"dot"`
+	} `json:"dots"`
+}
+
+// orderedForwards keeps the forwards of the Unbound model in configuration order, which is lost when decoding into a map.
+type orderedForwards struct {
+	ids      []string
+	forwards map[string]*unbound.Forward
+}
+
+func (o *orderedForwards) UnmarshalJSON(data []byte) error {
+	o.forwards = map[string]*unbound.Forward{}
+
+	// OPNsense returns an empty array instead of an object when no forwards exist
+	if bytes.Equal(bytes.TrimSpace(data), []byte("[]")) {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("unable to decode forwards: expected object")
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		id, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("unable to decode forwards: expected uuid")
+		}
+
+		forward := &unbound.Forward{}
+		if err := dec.Decode(forward); err != nil {
+			return err
+		}
+		o.ids = append(o.ids, id)
+		o.forwards[id] = forward
+	}
+
+	return nil
+}
+
+// Operations
+
+// GetForwardAll returns all forwards keyed by UUID, along with their UUIDs in the order Unbound uses them.
+func (c *Controller) GetForwardAll(ctx context.Context) ([]string, map[string]*unbound.Forward, error) {
+	model, err := api.GetFilter(c.Client(), ctx, forwardModelOpts, &forwardModel{}, forwardModelOpts.Monad)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return model.Dots.Dot.ids, model.Dots.Dot.forwards, nil
+}
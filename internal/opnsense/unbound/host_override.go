@@ -0,0 +1,44 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"terraform-provider-opnsense/internal/opnsense/search"
+)
+
+var hostOverrideSearchOpts = api.ReqOpts{
+	GetEndpoint: "/unbound/settings/searchHostOverride",
+}
+
+// Data structs
+
+// HostOverride extends the upstream host override with TXT record data.
+type HostOverride struct {
+	unbound.HostOverride
+
+	TXTData string `json:"txtdata"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddHostOverride(ctx context.Context, resource *HostOverride) (string, error) {
+	return api.Add(c.Client(), ctx, unbound.HostOverrideOpts, resource)
+}
+
+func (c *Controller) GetHostOverride(ctx context.Context, id string) (*HostOverride, error) {
+	return api.Get(c.Client(), ctx, unbound.HostOverrideOpts, &HostOverride{}, id)
+}
+
+func (c *Controller) UpdateHostOverride(ctx context.Context, id string, resource *HostOverride) error {
+	return api.Update(c.Client(), ctx, unbound.HostOverrideOpts, resource, id)
+}
+
+func (c *Controller) DeleteHostOverride(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, unbound.HostOverrideOpts, id)
+}
+
+// GetHostOverrideAll returns all host overrides, keyed by UUID.
+func (c *Controller) GetHostOverrideAll(ctx context.Context) (map[string]*HostOverride, error) {
+	return search.Rows[HostOverride](c.Client(), ctx, hostOverrideSearchOpts)
+}
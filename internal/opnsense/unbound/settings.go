@@ -0,0 +1,77 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var SettingsOpts = api.ReqOpts{
+	AddEndpoint:         "/unbound/settings/set",
+	GetEndpoint:         "/unbound/settings/get",
+	ReconfigureEndpoint: unboundReconfigureEndpoint,
+	Monad:               "unbound",
+}
+
+// Data structs
+
+// Settings holds the parts of the Unbound model which make up the general resolver configuration.
+type Settings struct {
+	General    SettingsGeneral    `json:"general"`
+	Advanced   SettingsAdvanced   `json:"advanced"`
+	Forwarding SettingsForwarding `json:"forwarding"`
+}
+
+type SettingsGeneral struct {
+	Enabled            string              `json:"enabled"`
+	Port               string              `json:"port"`
+	ActiveInterface    api.SelectedMapList `json:"active_interface"`
+	OutgoingInterface  api.SelectedMapList `json:"outgoing_interface"`
+	DNSSEC             string              `json:"dnssec"`
+	DNS64              string              `json:"dns64"`
+	DNS64Prefix        string              `json:"dns64prefix"`
+	RegisterDHCP       string              `json:"regdhcp"`
+	RegisterDHCPStatic string              `json:"regdhcpstatic"`
+	LocalZoneType      api.SelectedMap     `json:"local_zone_type"`
+}
+
+type SettingsAdvanced struct {
+	Prefetch         string `json:"prefetch"`
+	ServeExpired     string `json:"serveexpired"`
+	MessageCacheSize string `json:"msgcachesize"`
+	RRSetCacheSize   string `json:"rrsetcachesize"`
+}
+
+type SettingsForwarding struct {
+	Enabled string `json:"enabled"`
+}
+
+// Operations
+
+func (c *Controller) GetSettings(ctx context.Context) (*Settings, error) {
+	return api.GetFilter(c.Client(), ctx, SettingsOpts, &Settings{}, SettingsOpts.Monad)
+}
+
+func (c *Controller) UpdateSettings(ctx context.Context, resource *Settings) error {
+	_, err := api.Add(c.Client(), ctx, SettingsOpts, resource)
+	return err
+}
+
+// DisableSystemNameserverForwarding turns off forwarding to the system nameservers, which takes precedence over
+// forwards without a domain. Nothing is changed when it is already turned off.
+func (c *Controller) DisableSystemNameserverForwarding(ctx context.Context) error {
+	settings, err := c.GetSettings(ctx)
+	if err != nil {
+		return err
+	}
+	if settings.Forwarding.Enabled != "1" {
+		return nil
+	}
+
+	// Only the forwarding section is sent, so the remaining settings are left untouched
+	_, err = api.Add(c.Client(), ctx, SettingsOpts, &struct {
+		Forwarding SettingsForwarding `json:"forwarding"`
+	}{
+		Forwarding: SettingsForwarding{Enabled: "0"},
+	})
+	return err
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"os"
 	"strconv"
+	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/service"
 )
 
@@ -266,7 +267,7 @@ func (p *OPNsenseProvider) Configure(ctx context.Context, req provider.Configure
 		MinBackoff:    minBackoff,
 		MaxRetries:    retries,
 	}
-	client := api.NewClient(opnOptions)
+	client := opnsense.NewClient(opnOptions)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -283,16 +284,34 @@ func (p *OPNsenseProvider) Resources(ctx context.Context) []func() resource.Reso
 		service.NewUnboundHostAliasResource,
 		service.NewUnboundDomainOverrideResource,
 		service.NewUnboundForwardResource,
+		service.NewUnboundForwardSetResource,
+		service.NewUnboundSettingsResource,
+		service.NewUnboundACLResource,
+		service.NewUnboundDNSBLResource,
+		service.NewUnboundCacheFlushResource,
 		// Wireguard
 		service.NewWireguardServerResource,
 		service.NewWireguardClientResource,
 		// Quagga
+		service.NewQuaggaBGPResource,
 		service.NewQuaggaBGPNeighborResource,
+		service.NewQuaggaBGPPeerGroupResource,
 		service.NewQuaggaOSPFInterfaceResource,
 		service.NewQuaggaBGPASPathResource,
 		service.NewQuaggaBGPPrefixListResource,
+		service.NewQuaggaBGPPrefixListSetResource,
 		service.NewQuaggaBGPCommunityListResource,
+		service.NewQuaggaBGPCommunityListSetResource,
 		service.NewQuaggaBGPRouteMapResource,
+		service.NewQuaggaOSPFResource,
+		service.NewQuaggaOSPFNetworkResource,
+		service.NewQuaggaOSPFAreaResource,
+		service.NewQuaggaOSPF6Resource,
+		service.NewQuaggaOSPF6InterfaceResource,
+		service.NewQuaggaBFDResource,
+		service.NewQuaggaBFDNeighborResource,
+		service.NewQuaggaStaticRouteResource,
+		service.NewQuaggaRIPResource,
 		// Firewall
 		service.NewFirewallFilterResource,
 		service.NewFirewallNATResource,
@@ -302,6 +321,20 @@ func (p *OPNsenseProvider) Resources(ctx context.Context) []func() resource.Reso
 		service.NewKeaSubnetResource,
 		service.NewKeaPeerResource,
 		service.NewKeaReservationResource,
+		service.NewKeaDHCPv4Resource,
+		service.NewKeaSubnet6Resource,
+		service.NewKeaPDPoolResource,
+		service.NewKeaReservation6Resource,
+		service.NewKeaOptionResource,
+		// Dnsmasq
+		service.NewDnsmasqSettingsResource,
+		service.NewDnsmasqHostResource,
+		service.NewDnsmasqDomainOverrideResource,
+		service.NewDnsmasqDHCPRangeResource,
+		service.NewDnsmasqDHCPOptionResource,
+		// Dynamic DNS
+		service.NewDynDNSSettingsResource,
+		service.NewDynDNSAccountResource,
 	}
 }
 
@@ -313,21 +346,43 @@ func (p *OPNsenseProvider) DataSources(ctx context.Context) []func() datasource.
 		service.NewInterfaceAllDataSource,
 		// Routes
 		service.NewRouteDataSource,
+		service.NewRoutingGatewayStatusDataSource,
+		service.NewRoutingTableDataSource,
 		// Unbound
 		service.NewUnboundHostOverrideDataSource,
+		service.NewUnboundHostOverridesDataSource,
 		service.NewUnboundHostAliasDataSource,
 		service.NewUnboundDomainOverrideDataSource,
 		service.NewUnboundForwardDataSource,
+		service.NewUnboundSettingsDataSource,
+		service.NewUnboundACLDataSource,
+		service.NewUnboundDNSBLDataSource,
+		service.NewUnboundStatsDataSource,
+		service.NewUnboundResolveDataSource,
 		// Wireguard
 		service.NewWireguardServerDataSource,
 		service.NewWireguardClientDataSource,
 		// Quagga
+		service.NewQuaggaBGPDataSource,
 		service.NewQuaggaBGPNeighborDataSource,
+		service.NewQuaggaBGPPeerGroupDataSource,
 		service.NewQuaggaOSPFInterfaceDataSource,
 		service.NewQuaggaBGPASPathDataSource,
 		service.NewQuaggaBGPPrefixListDataSource,
 		service.NewQuaggaBGPCommunityListDataSource,
 		service.NewQuaggaBGPRouteMapDataSource,
+		service.NewQuaggaOSPFDataSource,
+		service.NewQuaggaOSPFNetworkDataSource,
+		service.NewQuaggaOSPFAreaDataSource,
+		service.NewQuaggaOSPF6DataSource,
+		service.NewQuaggaOSPF6InterfaceDataSource,
+		service.NewQuaggaBGPSummaryDataSource,
+		service.NewQuaggaOSPFNeighborsDataSource,
+		service.NewQuaggaBFDDataSource,
+		service.NewQuaggaBFDNeighborDataSource,
+		service.NewQuaggaBFDStatusDataSource,
+		service.NewQuaggaStaticRouteDataSource,
+		service.NewQuaggaRIPDataSource,
 		// Firewall
 		service.NewFirewallFilterDataSource,
 		service.NewFirewallNATDataSource,
@@ -337,6 +392,20 @@ func (p *OPNsenseProvider) DataSources(ctx context.Context) []func() datasource.
 		service.NewKeaSubnetDataSource,
 		service.NewKeaPeerDataSource,
 		service.NewKeaReservationDataSource,
+		service.NewKeaDHCPv4DataSource,
+		service.NewKeaSubnet6DataSource,
+		service.NewKeaPDPoolDataSource,
+		service.NewKeaReservation6DataSource,
+		service.NewKeaOptionDataSource,
+		// Dnsmasq
+		service.NewDnsmasqSettingsDataSource,
+		service.NewDnsmasqHostDataSource,
+		service.NewDnsmasqDomainOverrideDataSource,
+		service.NewDnsmasqDHCPRangeDataSource,
+		service.NewDnsmasqDHCPOptionDataSource,
+		// Dynamic DNS
+		service.NewDynDNSSettingsDataSource,
+		service.NewDynDNSAccountDataSource,
 	}
 }
 
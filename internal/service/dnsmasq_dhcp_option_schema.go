@@ -0,0 +1,186 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// DnsmasqDHCPOptionResourceModel describes the resource data model.
+type DnsmasqDHCPOptionResourceModel struct {
+	Type        types.String `tfsdk:"type"`
+	Option      types.Int64  `tfsdk:"option"`
+	Option6     types.Int64  `tfsdk:"option6"`
+	Interface   types.String `tfsdk:"interface"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Value       types.String `tfsdk:"value"`
+	Force       types.Bool   `tfsdk:"force"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqDHCPOptionResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "DHCP options are sent to DHCP clients, or used to tag clients that request them.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Whether to `set` the option for clients, or to `match` clients that send the option and tag them. Defaults to `\"set\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("set"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("set", "match"),
+				},
+			},
+			"option": schema.Int64Attribute{
+				MarkdownDescription: "The DHCPv4 option code, e.g. `6` for the DNS servers. Exactly one of `option` and `option6` must be set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 254),
+					int64validator.ExactlyOneOf(path.MatchRoot("option6")),
+				},
+			},
+			"option6": schema.Int64Attribute{
+				MarkdownDescription: "The DHCPv6 option code, e.g. `23` for the DNS servers. Exactly one of `option` and `option6` must be set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Only send the option on this interface. This uses an identifier like `lan` or `opt2`. Sent on all interfaces when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only send the option to clients with these tags, or the tags to set on matching clients. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the option, e.g. `192.168.1.1,192.168.1.2`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Always send the option, even when the client does not request it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this option. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqDHCPOptionDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "DHCP options are sent to DHCP clients, or used to tag clients that request them.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "Whether the option is `set` for clients, or used to `match` and tag clients.",
+				Computed:            true,
+			},
+			"option": dschema.Int64Attribute{
+				MarkdownDescription: "The DHCPv4 option code.",
+				Computed:            true,
+			},
+			"option6": dschema.Int64Attribute{
+				MarkdownDescription: "The DHCPv6 option code.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface the option is sent on, all interfaces when empty.",
+				Computed:            true,
+			},
+			"tags": dschema.SetAttribute{
+				MarkdownDescription: "Tags of the clients the option is sent to, or the tags set on matching clients.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"value": dschema.StringAttribute{
+				MarkdownDescription: "Value of the option.",
+				Computed:            true,
+			},
+			"force": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the option is always sent.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this option.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqDHCPOptionSchemaToStruct(d *DnsmasqDHCPOptionResourceModel) (*dnsmasq.DHCPOption, error) {
+	// Only one of the option codes is set, the other is sent empty
+	option := ""
+	if !d.Option.IsNull() {
+		option = tools.Int64ToString(d.Option.ValueInt64())
+	}
+	option6 := ""
+	if !d.Option6.IsNull() {
+		option6 = tools.Int64ToString(d.Option6.ValueInt64())
+	}
+
+	return &dnsmasq.DHCPOption{
+		Type:        api.SelectedMap(d.Type.ValueString()),
+		Option:      api.SelectedMap(option),
+		Option6:     api.SelectedMap(option6),
+		Interface:   api.SelectedMap(d.Interface.ValueString()),
+		Tags:        tools.SetToStringSlice(d.Tags),
+		Value:       d.Value.ValueString(),
+		Force:       tools.BoolToString(d.Force.ValueBool()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqDHCPOptionStructToSchema(d *dnsmasq.DHCPOption) (*DnsmasqDHCPOptionResourceModel, error) {
+	return &DnsmasqDHCPOptionResourceModel{
+		Type:        types.StringValue(d.Type.String()),
+		Option:      tools.StringToInt64Null(d.Option.String()),
+		Option6:     tools.StringToInt64Null(d.Option6.String()),
+		Interface:   types.StringValue(d.Interface.String()),
+		Tags:        tools.StringSliceToSet(d.Tags),
+		Value:       types.StringValue(d.Value),
+		Force:       types.BoolValue(tools.StringToBool(d.Force)),
+		Description: types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// DnsmasqDHCPRangeResourceModel describes the resource data model.
+type DnsmasqDHCPRangeResourceModel struct {
+	Interface    types.String `tfsdk:"interface"`
+	StartAddress types.String `tfsdk:"start_address"`
+	EndAddress   types.String `tfsdk:"end_address"`
+	SubnetMask   types.String `tfsdk:"subnet_mask"`
+	Constructor  types.String `tfsdk:"constructor"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	LeaseTime    types.Int64  `tfsdk:"lease_time"`
+	Domain       types.String `tfsdk:"domain"`
+	Description  types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqDHCPRangeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "DHCP ranges define the pools of addresses Dnsmasq hands out to DHCP clients.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface to serve the range on. This uses an identifier like `lan` or `opt2`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"start_address": schema.StringAttribute{
+				MarkdownDescription: "The first address of the range. For IPv6 ranges with a constructor, only the suffix is used, e.g. `::1000`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"end_address": schema.StringAttribute{
+				MarkdownDescription: "The last address of the range. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"subnet_mask": schema.StringAttribute{
+				MarkdownDescription: "Subnet mask of the IPv4 range, e.g. `255.255.255.0`. Derived from the interface when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"constructor": schema.StringAttribute{
+				MarkdownDescription: "Interface to take the IPv6 prefix of the range from, e.g. for tracked interfaces with a dynamic prefix. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prefix_length": schema.Int64Attribute{
+				MarkdownDescription: "Prefix length of the IPv6 range. Set to `-1` to use the default of `64`. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 128),
+					),
+				},
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds of the range. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain offered to the DHCP clients of this range. Uses the DHCP domain of the settings when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this range. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqDHCPRangeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "DHCP ranges define the pools of addresses Dnsmasq hands out to DHCP clients.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface the range is served on.",
+				Computed:            true,
+			},
+			"start_address": dschema.StringAttribute{
+				MarkdownDescription: "The first address of the range.",
+				Computed:            true,
+			},
+			"end_address": dschema.StringAttribute{
+				MarkdownDescription: "The last address of the range.",
+				Computed:            true,
+			},
+			"subnet_mask": dschema.StringAttribute{
+				MarkdownDescription: "Subnet mask of the IPv4 range.",
+				Computed:            true,
+			},
+			"constructor": dschema.StringAttribute{
+				MarkdownDescription: "Interface the IPv6 prefix of the range is taken from.",
+				Computed:            true,
+			},
+			"prefix_length": dschema.Int64Attribute{
+				MarkdownDescription: "Prefix length of the IPv6 range, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"lease_time": dschema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds of the range, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain offered to the DHCP clients of this range.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this range.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqDHCPRangeSchemaToStruct(d *DnsmasqDHCPRangeResourceModel) (*dnsmasq.DHCPRange, error) {
+	return &dnsmasq.DHCPRange{
+		Interface:    api.SelectedMap(d.Interface.ValueString()),
+		StartAddress: d.StartAddress.ValueString(),
+		EndAddress:   d.EndAddress.ValueString(),
+		SubnetMask:   d.SubnetMask.ValueString(),
+		Constructor:  api.SelectedMap(d.Constructor.ValueString()),
+		PrefixLength: tools.Int64ToStringNegative(d.PrefixLength.ValueInt64()),
+		LeaseTime:    tools.Int64ToStringNegative(d.LeaseTime.ValueInt64()),
+		Domain:       d.Domain.ValueString(),
+		Description:  d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqDHCPRangeStructToSchema(d *dnsmasq.DHCPRange) (*DnsmasqDHCPRangeResourceModel, error) {
+	return &DnsmasqDHCPRangeResourceModel{
+		Interface:    types.StringValue(d.Interface.String()),
+		StartAddress: types.StringValue(d.StartAddress),
+		EndAddress:   types.StringValue(d.EndAddress),
+		SubnetMask:   types.StringValue(d.SubnetMask),
+		Constructor:  types.StringValue(d.Constructor.String()),
+		PrefixLength: types.Int64Value(tools.StringToInt64(d.PrefixLength)),
+		LeaseTime:    types.Int64Value(tools.StringToInt64(d.LeaseTime)),
+		Domain:       types.StringValue(d.Domain),
+		Description:  types.StringValue(d.Description),
+	}, nil
+}
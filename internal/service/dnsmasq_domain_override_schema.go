@@ -0,0 +1,136 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// DnsmasqDomainOverrideResourceModel describes the resource data model.
+type DnsmasqDomainOverrideResourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	IP          types.String `tfsdk:"ip"`
+	Port        types.Int64  `tfsdk:"port"`
+	SourceIP    types.String `tfsdk:"source_ip"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqDomainOverrideResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Domain overrides forward all queries for a domain and its subdomains to a specific DNS server.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain to override, e.g. `example.com`.",
+				Required:            true,
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "IP address of the authoritative DNS server for the domain. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the DNS server. Set to `-1` to use the default port `53`. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(-1),
+						int64validator.Between(1, 65535),
+					),
+				},
+			},
+			"source_ip": schema.StringAttribute{
+				MarkdownDescription: "Local IP address to send the queries to the DNS server from. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this domain override. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqDomainOverrideDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Domain overrides forward all queries for a domain and its subdomains to a specific DNS server.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain to override.",
+				Computed:            true,
+			},
+			"ip": dschema.StringAttribute{
+				MarkdownDescription: "IP address of the authoritative DNS server for the domain.",
+				Computed:            true,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "Port of the DNS server, `-1` if the default port is used.",
+				Computed:            true,
+			},
+			"source_ip": dschema.StringAttribute{
+				MarkdownDescription: "Local IP address to send the queries to the DNS server from.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this domain override.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqDomainOverrideSchemaToStruct(d *DnsmasqDomainOverrideResourceModel) (*dnsmasq.DomainOverride, error) {
+	return &dnsmasq.DomainOverride{
+		Domain:      d.Domain.ValueString(),
+		IP:          d.IP.ValueString(),
+		Port:        tools.Int64ToStringNegative(d.Port.ValueInt64()),
+		SourceIP:    d.SourceIP.ValueString(),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqDomainOverrideStructToSchema(d *dnsmasq.DomainOverride) (*DnsmasqDomainOverrideResourceModel, error) {
+	return &DnsmasqDomainOverrideResourceModel{
+		Domain:      types.StringValue(d.Domain),
+		IP:          types.StringValue(d.IP),
+		Port:        types.Int64Value(tools.StringToInt64(d.Port)),
+		SourceIP:    types.StringValue(d.SourceIP),
+		Description: types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// DnsmasqHostResourceModel describes the resource data model.
+type DnsmasqHostResourceModel struct {
+	Hostname          types.String `tfsdk:"hostname"`
+	Domain            types.String `tfsdk:"domain"`
+	Local             types.Bool   `tfsdk:"local"`
+	IPAddresses       types.Set    `tfsdk:"ip_addresses"`
+	Aliases           types.Set    `tfsdk:"aliases"`
+	HardwareAddresses types.Set    `tfsdk:"hardware_addresses"`
+	ClientID          types.String `tfsdk:"client_id"`
+	LeaseTime         types.Int64  `tfsdk:"lease_time"`
+	IgnoreDHCP        types.Bool   `tfsdk:"ignore_dhcp"`
+	Description       types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqHostResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Host overrides answer DNS queries for a hostname with the configured addresses. When hardware addresses are set, the host also acts as a static DHCP reservation.",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Name of the host, without the domain part. Use `*` to match all hosts of the domain. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the host, e.g. `example.com`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"local": schema.BoolAttribute{
+				MarkdownDescription: "Treat the domain as local, so queries for unknown names in it are never forwarded upstream. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ip_addresses": schema.SetAttribute{
+				MarkdownDescription: "IPv4 and IPv6 addresses returned for the host. For DHCP reservations the IPv4 address is handed out to the client. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IPAddress()),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				MarkdownDescription: "Additional fully qualified names that resolve to the addresses of this host. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"hardware_addresses": schema.SetAttribute{
+				MarkdownDescription: "MAC addresses of the DHCP clients this host is reserved for. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier to match instead of a hardware address. Use `*` to ignore the client identifier. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds of the DHCP reservation. Set to `-1` to use the lease time of the range. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"ignore_dhcp": schema.BoolAttribute{
+				MarkdownDescription: "Ignore DHCP requests from the matching clients. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this host. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqHostDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Host overrides answer DNS queries for a hostname with the configured addresses. When hardware addresses are set, the host also acts as a static DHCP reservation.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"hostname": dschema.StringAttribute{
+				MarkdownDescription: "Name of the host, without the domain part.",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain of the host.",
+				Computed:            true,
+			},
+			"local": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is treated as local.",
+				Computed:            true,
+			},
+			"ip_addresses": dschema.SetAttribute{
+				MarkdownDescription: "IPv4 and IPv6 addresses returned for the host.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"aliases": dschema.SetAttribute{
+				MarkdownDescription: "Additional fully qualified names that resolve to the addresses of this host.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"hardware_addresses": dschema.SetAttribute{
+				MarkdownDescription: "MAC addresses of the DHCP clients this host is reserved for.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"client_id": dschema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier to match instead of a hardware address.",
+				Computed:            true,
+			},
+			"lease_time": dschema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds of the DHCP reservation, `-1` if the lease time of the range is used.",
+				Computed:            true,
+			},
+			"ignore_dhcp": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DHCP requests from the matching clients are ignored.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this host.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqHostSchemaToStruct(d *DnsmasqHostResourceModel) (*dnsmasq.Host, error) {
+	return &dnsmasq.Host{
+		Hostname:          d.Hostname.ValueString(),
+		Domain:            d.Domain.ValueString(),
+		Local:             tools.BoolToString(d.Local.ValueBool()),
+		IP:                tools.SetToStringSlice(d.IPAddresses),
+		Aliases:           tools.SetToStringSlice(d.Aliases),
+		HardwareAddresses: tools.SetToStringSlice(d.HardwareAddresses),
+		ClientID:          d.ClientID.ValueString(),
+		LeaseTime:         tools.Int64ToStringNegative(d.LeaseTime.ValueInt64()),
+		Ignore:            tools.BoolToString(d.IgnoreDHCP.ValueBool()),
+		Description:       d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqHostStructToSchema(d *dnsmasq.Host) (*DnsmasqHostResourceModel, error) {
+	return &DnsmasqHostResourceModel{
+		Hostname:          types.StringValue(d.Hostname),
+		Domain:            types.StringValue(d.Domain),
+		Local:             types.BoolValue(tools.StringToBool(d.Local)),
+		IPAddresses:       tools.StringSliceToSet(d.IP),
+		Aliases:           tools.StringSliceToSet(d.Aliases),
+		HardwareAddresses: tools.StringSliceToSet(d.HardwareAddresses),
+		ClientID:          types.StringValue(d.ClientID),
+		LeaseTime:         types.Int64Value(tools.StringToInt64(d.LeaseTime)),
+		IgnoreDHCP:        types.BoolValue(tools.StringToBool(d.Ignore)),
+		Description:       types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,333 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// dnsmasqSettingsId is the ID of the Dnsmasq settings singleton resource.
+const dnsmasqSettingsId = "settings"
+
+// DnsmasqSettingsResourceModel describes the resource data model.
+type DnsmasqSettingsResourceModel struct {
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	Interfaces                 types.Set    `tfsdk:"interfaces"`
+	Port                       types.Int64  `tfsdk:"port"`
+	DNSSEC                     types.Bool   `tfsdk:"dnssec"`
+	DomainNeeded               types.Bool   `tfsdk:"domain_needed"`
+	NoPrivateReverse           types.Bool   `tfsdk:"no_private_reverse"`
+	StrictOrder                types.Bool   `tfsdk:"strict_order"`
+	NoHosts                    types.Bool   `tfsdk:"no_hosts"`
+	LogQueries                 types.Bool   `tfsdk:"log_queries"`
+	RegisterDHCPLeases         types.Bool   `tfsdk:"register_dhcp_leases"`
+	RegisterDHCPStaticMappings types.Bool   `tfsdk:"register_dhcp_static_mappings"`
+	CacheSize                  types.Int64  `tfsdk:"cache_size"`
+	LocalTTL                   types.Int64  `tfsdk:"local_ttl"`
+	DHCPAuthoritative          types.Bool   `tfsdk:"dhcp_authoritative"`
+	DHCPDefaultFirewallRules   types.Bool   `tfsdk:"dhcp_default_firewall_rules"`
+	DHCPFQDN                   types.Bool   `tfsdk:"dhcp_fqdn"`
+	DHCPDomain                 types.String `tfsdk:"dhcp_domain"`
+	DHCPLeaseMax               types.Int64  `tfsdk:"dhcp_lease_max"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the Dnsmasq DNS and DHCP service. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable Dnsmasq. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces to listen on for DNS and DHCP requests. This uses identifiers like `lan` or `opt2`. Listens on all interfaces when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The TCP/UDP port used for responding to DNS queries. Set to `0` to disable DNS and only use DHCP. Defaults to `53`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(53),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Enable DNSSEC validation. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"domain_needed": schema.BoolAttribute{
+				MarkdownDescription: "Do not forward queries for plain names without dots or domain parts to the upstream servers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_private_reverse": schema.BoolAttribute{
+				MarkdownDescription: "Do not forward reverse lookups for private IP ranges to the upstream servers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"strict_order": schema.BoolAttribute{
+				MarkdownDescription: "Query the upstream servers in the order they are configured, instead of the fastest one. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_hosts": schema.BoolAttribute{
+				MarkdownDescription: "Do not read the hostnames of the system hosts file. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"log_queries": schema.BoolAttribute{
+				MarkdownDescription: "Log the results of all DNS queries. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_dhcp_leases": schema.BoolAttribute{
+				MarkdownDescription: "Register the hostnames of DHCP clients, so they can be resolved. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_dhcp_static_mappings": schema.BoolAttribute{
+				MarkdownDescription: "Register DHCP static mappings, so their hostnames can be resolved. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"cache_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of entries in the DNS cache. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"local_ttl": schema.Int64Attribute{
+				MarkdownDescription: "TTL in seconds of answers from the hosts file and DHCP leases. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"dhcp_authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Act as the only DHCP server on the network, answering requests for unknown leases immediately. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dhcp_default_firewall_rules": schema.BoolAttribute{
+				MarkdownDescription: "Automatically add the firewall rules needed for DHCP on the listening interfaces. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"dhcp_fqdn": schema.BoolAttribute{
+				MarkdownDescription: "Register DHCP clients with their fully qualified name, using the domain of their DHCP range. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"dhcp_domain": schema.StringAttribute{
+				MarkdownDescription: "Domain used for DHCP clients of ranges without a domain. Uses the system domain when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"dhcp_lease_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of DHCP leases. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the Dnsmasq settings, always `settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of the Dnsmasq DNS and DHCP service.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the Dnsmasq settings, always `settings`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Dnsmasq is enabled.",
+				Computed:            true,
+			},
+			"interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces to listen on for DNS and DHCP requests, all interfaces when empty.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "The TCP/UDP port used for responding to DNS queries, `0` if DNS is disabled.",
+				Computed:            true,
+			},
+			"dnssec": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DNSSEC validation is enabled.",
+				Computed:            true,
+			},
+			"domain_needed": dschema.BoolAttribute{
+				MarkdownDescription: "Whether queries for plain names are kept from the upstream servers.",
+				Computed:            true,
+			},
+			"no_private_reverse": dschema.BoolAttribute{
+				MarkdownDescription: "Whether reverse lookups for private IP ranges are kept from the upstream servers.",
+				Computed:            true,
+			},
+			"strict_order": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the upstream servers are queried in the order they are configured.",
+				Computed:            true,
+			},
+			"no_hosts": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the system hosts file is ignored.",
+				Computed:            true,
+			},
+			"log_queries": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the results of all DNS queries are logged.",
+				Computed:            true,
+			},
+			"register_dhcp_leases": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the hostnames of DHCP clients are registered.",
+				Computed:            true,
+			},
+			"register_dhcp_static_mappings": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DHCP static mappings are registered.",
+				Computed:            true,
+			},
+			"cache_size": dschema.Int64Attribute{
+				MarkdownDescription: "Number of entries in the DNS cache, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"local_ttl": dschema.Int64Attribute{
+				MarkdownDescription: "TTL in seconds of answers from the hosts file and DHCP leases, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"dhcp_authoritative": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Dnsmasq acts as the only DHCP server on the network.",
+				Computed:            true,
+			},
+			"dhcp_default_firewall_rules": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the firewall rules needed for DHCP are added automatically.",
+				Computed:            true,
+			},
+			"dhcp_fqdn": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DHCP clients are registered with their fully qualified name.",
+				Computed:            true,
+			},
+			"dhcp_domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain used for DHCP clients of ranges without a domain, the system domain when empty.",
+				Computed:            true,
+			},
+			"dhcp_lease_max": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of DHCP leases, `-1` if the default is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// dnsmasqSettingsDefaults returns the Dnsmasq settings of a fresh OPNsense install, used to reset the singleton.
+func dnsmasqSettingsDefaults() *dnsmasq.Settings {
+	return &dnsmasq.Settings{
+		Enabled:            "0",
+		Interfaces:         api.SelectedMapList{},
+		Port:               "53",
+		DNSSEC:             "0",
+		DomainNeeded:       "0",
+		NoPrivateReverse:   "0",
+		StrictOrder:        "0",
+		NoHosts:            "0",
+		LogQueries:         "0",
+		RegisterDHCP:       "0",
+		RegisterDHCPStatic: "0",
+		CacheSize:          "",
+		LocalTTL:           "",
+		DHCP: dnsmasq.SettingsDHCP{
+			Authoritative:        "0",
+			DefaultFirewallRules: "1",
+			FQDN:                 "1",
+			Domain:               "",
+			LeaseMax:             "",
+		},
+	}
+}
+
+func convertDnsmasqSettingsSchemaToStruct(d *DnsmasqSettingsResourceModel) (*dnsmasq.Settings, error) {
+	return &dnsmasq.Settings{
+		Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+		Interfaces:         tools.SetToStringSlice(d.Interfaces),
+		Port:               tools.Int64ToString(d.Port.ValueInt64()),
+		DNSSEC:             tools.BoolToString(d.DNSSEC.ValueBool()),
+		DomainNeeded:       tools.BoolToString(d.DomainNeeded.ValueBool()),
+		NoPrivateReverse:   tools.BoolToString(d.NoPrivateReverse.ValueBool()),
+		StrictOrder:        tools.BoolToString(d.StrictOrder.ValueBool()),
+		NoHosts:            tools.BoolToString(d.NoHosts.ValueBool()),
+		LogQueries:         tools.BoolToString(d.LogQueries.ValueBool()),
+		RegisterDHCP:       tools.BoolToString(d.RegisterDHCPLeases.ValueBool()),
+		RegisterDHCPStatic: tools.BoolToString(d.RegisterDHCPStaticMappings.ValueBool()),
+		CacheSize:          tools.Int64ToStringNegative(d.CacheSize.ValueInt64()),
+		LocalTTL:           tools.Int64ToStringNegative(d.LocalTTL.ValueInt64()),
+		DHCP: dnsmasq.SettingsDHCP{
+			Authoritative:        tools.BoolToString(d.DHCPAuthoritative.ValueBool()),
+			DefaultFirewallRules: tools.BoolToString(d.DHCPDefaultFirewallRules.ValueBool()),
+			FQDN:                 tools.BoolToString(d.DHCPFQDN.ValueBool()),
+			Domain:               d.DHCPDomain.ValueString(),
+			LeaseMax:             tools.Int64ToStringNegative(d.DHCPLeaseMax.ValueInt64()),
+		},
+	}, nil
+}
+
+func convertDnsmasqSettingsStructToSchema(d *dnsmasq.Settings) (*DnsmasqSettingsResourceModel, error) {
+	return &DnsmasqSettingsResourceModel{
+		Enabled:                    types.BoolValue(tools.StringToBool(d.Enabled)),
+		Interfaces:                 tools.StringSliceToSet(d.Interfaces),
+		Port:                       types.Int64Value(tools.StringToInt64(d.Port)),
+		DNSSEC:                     types.BoolValue(tools.StringToBool(d.DNSSEC)),
+		DomainNeeded:               types.BoolValue(tools.StringToBool(d.DomainNeeded)),
+		NoPrivateReverse:           types.BoolValue(tools.StringToBool(d.NoPrivateReverse)),
+		StrictOrder:                types.BoolValue(tools.StringToBool(d.StrictOrder)),
+		NoHosts:                    types.BoolValue(tools.StringToBool(d.NoHosts)),
+		LogQueries:                 types.BoolValue(tools.StringToBool(d.LogQueries)),
+		RegisterDHCPLeases:         types.BoolValue(tools.StringToBool(d.RegisterDHCP)),
+		RegisterDHCPStaticMappings: types.BoolValue(tools.StringToBool(d.RegisterDHCPStatic)),
+		CacheSize:                  types.Int64Value(tools.StringToInt64(d.CacheSize)),
+		LocalTTL:                   types.Int64Value(tools.StringToInt64(d.LocalTTL)),
+		DHCPAuthoritative:          types.BoolValue(tools.StringToBool(d.DHCP.Authoritative)),
+		DHCPDefaultFirewallRules:   types.BoolValue(tools.StringToBool(d.DHCP.DefaultFirewallRules)),
+		DHCPFQDN:                   types.BoolValue(tools.StringToBool(d.DHCP.FQDN)),
+		DHCPDomain:                 types.StringValue(d.DHCP.Domain),
+		DHCPLeaseMax:               types.Int64Value(tools.StringToInt64(d.DHCP.LeaseMax)),
+	}, nil
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DynDNSAccountResource{}
+var _ resource.ResourceWithImportState = &DynDNSAccountResource{}
+var _ resource.ResourceWithModifyPlan = &DynDNSAccountResource{}
+var _ resource.ResourceWithUpgradeState = &DynDNSAccountResource{}
+
+func NewDynDNSAccountResource() resource.Resource {
+	return &DynDNSAccountResource{}
+}
+
+// DynDNSAccountResource defines the resource implementation.
+type DynDNSAccountResource struct {
+	client opnsense.Client
+}
+
+func (r *DynDNSAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dyndns_account"
+}
+
+func (r *DynDNSAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dynDNSAccountResourceSchema()
+}
+
+func (r *DynDNSAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DynDNSAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DynDNSAccountResourceModel
+
+	// Read Terraform plan data into the model, the write-only password is only available in the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &data.Password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	account, err := convertDynDNSAccountSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse dyndns account, got error: %s", err))
+		return
+	}
+
+	// Add dyndns account to dyndns
+	id, err := r.client.DynDNS().AddAccount(ctx, account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create dyndns account, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DynDNSAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DynDNSAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get dyndns account from OPNsense dyndns API
+	account, err := r.client.DynDNS().GetAccount(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("dyndns account not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read dyndns account, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	accountModel, err := convertDynDNSAccountStructToSchema(account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read dyndns account, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	accountModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &accountModel)...)
+}
+
+func (r *DynDNSAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DynDNSAccountResourceModel
+
+	// Read Terraform plan data into the model, the write-only password is only available in the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &data.Password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	account, err := convertDynDNSAccountSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse dyndns account, got error: %s", err))
+		return
+	}
+
+	// Update dyndns account in dyndns
+	err = r.client.DynDNS().UpdateAccount(ctx, data.Id.ValueString(), account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create dyndns account, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DynDNSAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DynDNSAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DynDNS().DeleteAccount(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete dyndns account, got error: %s", err))
+		return
+	}
+}
+
+func (r *DynDNSAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The password is write-only, plan its hash so that changing it updates the resource
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &secret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_hash"), tools.SecretHashValue(secret))...)
+}
+
+func (r *DynDNSAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *DynDNSAccountResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := dynDNSAccountResourceSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored password in plain text
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior *DynDNSAccountResourceModel
+
+				// Read prior state data into the model
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Save upgraded data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeDynDNSAccountResourceModelV0(prior))...)
+			},
+		},
+	}
+}
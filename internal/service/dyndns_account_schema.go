@@ -0,0 +1,263 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dyndns"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// DynDNSAccountResourceModel describes the resource data model.
+type DynDNSAccountResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Service      types.String `tfsdk:"service"`
+	Protocol     types.String `tfsdk:"protocol"`
+	Server       types.String `tfsdk:"server"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	PasswordHash types.String `tfsdk:"password_hash"`
+	Hostnames    types.Set    `tfsdk:"hostnames"`
+	Wildcard     types.Bool   `tfsdk:"wildcard"`
+	Zone         types.String `tfsdk:"zone"`
+	CheckIP      types.String `tfsdk:"check_ip"`
+	Interface    types.String `tfsdk:"interface"`
+	ForceSSL     types.Bool   `tfsdk:"force_ssl"`
+	Description  types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// dynDNSAccountResourceSchemaV0 returns version 0 of the resource schema, used to upgrade prior state.
+func dynDNSAccountResourceSchemaV0() schema.Schema {
+	s := dynDNSAccountResourceSchema()
+	s.Version = 0
+	s.Attributes["password"] = schema.StringAttribute{
+		Optional:  true,
+		Computed:  true,
+		Sensitive: true,
+	}
+	return s
+}
+
+// upgradeDynDNSAccountResourceModelV0 converts version 0 state, which stored the password in plain text. The
+// password is write-only now, so only its hash is kept.
+func upgradeDynDNSAccountResourceModelV0(d *DynDNSAccountResourceModel) *DynDNSAccountResourceModel {
+	d.PasswordHash = types.StringValue(tools.HashSecret(d.Password.ValueString()))
+	d.Password = types.StringNull()
+	return d
+}
+
+func dynDNSAccountResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Dynamic DNS accounts register the current address of the firewall with a DNS provider (os-ddclient plugin).",
+		Version:             1,
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this account. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "The DNS provider, e.g. `cloudflare`, `duckdns`, `he-net` or `custom`. The available providers depend on the plugin version and backend.",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The update protocol of a `custom` service, e.g. `dyndns2`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The update server of a `custom` service. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate with the provider. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password or API token used to authenticate with the provider. Write-only, it is sent to OPNsense but never stored in the Terraform state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of `password`, empty when no password is set. Changes of the password, in the configuration or on the firewall, show up as changes of this hash.",
+				Computed:            true,
+			},
+			"hostnames": schema.SetAttribute{
+				MarkdownDescription: "The fully qualified hostnames to update.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"wildcard": schema.BoolAttribute{
+				MarkdownDescription: "Also update the wildcard record of the hostnames. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The DNS zone of the hostnames, required by some providers such as `cloudflare`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"check_ip": schema.StringAttribute{
+				MarkdownDescription: "The method to determine the current address. Use `if` to take the address of `interface`, or a web service such as `web_dyndns` or `web_ipify-ipv4` when the firewall is behind NAT. Defaults to `\"web_dyndns\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("web_dyndns"),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface to monitor for address changes, and to take the address from when `check_ip` is `if`. This uses an identifier like `wan` or `opt2`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"force_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Use SSL to send the updates to the provider. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this account. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DynDNSAccountDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Dynamic DNS accounts register the current address of the firewall with a DNS provider (os-ddclient plugin).",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this account is enabled.",
+				Computed:            true,
+			},
+			"service": dschema.StringAttribute{
+				MarkdownDescription: "The DNS provider.",
+				Computed:            true,
+			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "The update protocol of a `custom` service.",
+				Computed:            true,
+			},
+			"server": dschema.StringAttribute{
+				MarkdownDescription: "The update server of a `custom` service.",
+				Computed:            true,
+			},
+			"username": dschema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate with the provider.",
+				Computed:            true,
+			},
+			"password": dschema.StringAttribute{
+				MarkdownDescription: "Always null, secrets are not exposed by data sources.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"password_hash": dschema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the password or API token, empty when no password is set.",
+				Computed:            true,
+			},
+			"hostnames": dschema.SetAttribute{
+				MarkdownDescription: "The fully qualified hostnames to update.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"wildcard": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the wildcard record of the hostnames is updated.",
+				Computed:            true,
+			},
+			"zone": dschema.StringAttribute{
+				MarkdownDescription: "The DNS zone of the hostnames.",
+				Computed:            true,
+			},
+			"check_ip": dschema.StringAttribute{
+				MarkdownDescription: "The method to determine the current address.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface monitored for address changes.",
+				Computed:            true,
+			},
+			"force_ssl": dschema.BoolAttribute{
+				MarkdownDescription: "Whether SSL is used to send the updates.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this account.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDynDNSAccountSchemaToStruct(d *DynDNSAccountResourceModel) (*dyndns.Account, error) {
+	return &dyndns.Account{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Service:     api.SelectedMap(d.Service.ValueString()),
+		Protocol:    api.SelectedMap(d.Protocol.ValueString()),
+		Server:      d.Server.ValueString(),
+		Username:    d.Username.ValueString(),
+		Password:    d.Password.ValueString(),
+		Hostnames:   tools.SetToStringSlice(d.Hostnames),
+		Wildcard:    tools.BoolToString(d.Wildcard.ValueBool()),
+		Zone:        d.Zone.ValueString(),
+		CheckIP:     api.SelectedMap(d.CheckIP.ValueString()),
+		Interface:   api.SelectedMap(d.Interface.ValueString()),
+		ForceSSL:    tools.BoolToString(d.ForceSSL.ValueBool()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertDynDNSAccountStructToSchema(d *dyndns.Account) (*DynDNSAccountResourceModel, error) {
+	return &DynDNSAccountResourceModel{
+		Enabled:      types.BoolValue(tools.StringToBool(d.Enabled)),
+		Service:      types.StringValue(d.Service.String()),
+		Protocol:     types.StringValue(d.Protocol.String()),
+		Server:       types.StringValue(d.Server),
+		Username:     types.StringValue(d.Username),
+		Password:     types.StringNull(),
+		PasswordHash: types.StringValue(tools.HashSecret(d.Password)),
+		Hostnames:    tools.StringSliceToSet(d.Hostnames),
+		Wildcard:     types.BoolValue(tools.StringToBool(d.Wildcard)),
+		Zone:         types.StringValue(d.Zone),
+		CheckIP:      types.StringValue(d.CheckIP.String()),
+		Interface:    types.StringValue(d.Interface.String()),
+		ForceSSL:     types.BoolValue(tools.StringToBool(d.ForceSSL)),
+		Description:  types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dyndns"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// dynDNSSettingsId is the ID of the dynamic DNS settings singleton resource.
+const dynDNSSettingsId = "settings"
+
+// DynDNSSettingsResourceModel describes the resource data model.
+type DynDNSSettingsResourceModel struct {
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Verbose   types.Bool   `tfsdk:"verbose"`
+	AllowIPv6 types.Bool   `tfsdk:"allow_ipv6"`
+	Interval  types.Int64  `tfsdk:"interval"`
+	Backend   types.String `tfsdk:"backend"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dynDNSSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the dynamic DNS service (os-ddclient plugin). This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the dynamic DNS service. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"verbose": schema.BoolAttribute{
+				MarkdownDescription: "Log verbose output of the updates. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_ipv6": schema.BoolAttribute{
+				MarkdownDescription: "Allow IPv6 addresses to be registered. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between checks for a changed address. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"backend": schema.StringAttribute{
+				MarkdownDescription: "The client updating the accounts, either `ddclient` or the native `opnsense` client. Defaults to `\"ddclient\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ddclient"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ddclient", "opnsense"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the dynamic DNS settings, always `settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DynDNSSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of the dynamic DNS service (os-ddclient plugin).",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the dynamic DNS settings, always `settings`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the dynamic DNS service is enabled.",
+				Computed:            true,
+			},
+			"verbose": dschema.BoolAttribute{
+				MarkdownDescription: "Whether verbose output of the updates is logged.",
+				Computed:            true,
+			},
+			"allow_ipv6": dschema.BoolAttribute{
+				MarkdownDescription: "Whether IPv6 addresses are registered.",
+				Computed:            true,
+			},
+			"interval": dschema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between checks for a changed address.",
+				Computed:            true,
+			},
+			"backend": dschema.StringAttribute{
+				MarkdownDescription: "The client updating the accounts.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// dynDNSSettingsDefaults returns the dynamic DNS settings of a fresh plugin install, used to reset the singleton.
+func dynDNSSettingsDefaults() *dyndns.Settings {
+	return &dyndns.Settings{
+		General: dyndns.SettingsGeneral{
+			Enabled:     "0",
+			Verbose:     "0",
+			AllowIPv6:   "0",
+			DaemonDelay: "300",
+			Backend:     api.SelectedMap("ddclient"),
+		},
+	}
+}
+
+func convertDynDNSSettingsSchemaToStruct(d *DynDNSSettingsResourceModel) (*dyndns.Settings, error) {
+	return &dyndns.Settings{
+		General: dyndns.SettingsGeneral{
+			Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+			Verbose:     tools.BoolToString(d.Verbose.ValueBool()),
+			AllowIPv6:   tools.BoolToString(d.AllowIPv6.ValueBool()),
+			DaemonDelay: tools.Int64ToString(d.Interval.ValueInt64()),
+			Backend:     api.SelectedMap(d.Backend.ValueString()),
+		},
+	}, nil
+}
+
+func convertDynDNSSettingsStructToSchema(d *dyndns.Settings) (*DynDNSSettingsResourceModel, error) {
+	return &DynDNSSettingsResourceModel{
+		Enabled:   types.BoolValue(tools.StringToBool(d.General.Enabled)),
+		Verbose:   types.BoolValue(tools.StringToBool(d.General.Verbose)),
+		AllowIPv6: types.BoolValue(tools.StringToBool(d.General.AllowIPv6)),
+		Interval:  types.Int64Value(tools.StringToInt64(d.General.DaemonDelay)),
+		Backend:   types.StringValue(d.General.Backend.String()),
+	}, nil
+}
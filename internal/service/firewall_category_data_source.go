@@ -3,9 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -34,16 +33,16 @@ func (d *FirewallCategoryDataSource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = opnsense.NewClient(apiClient)
+	d.client = client
 }
 
 func (d *FirewallCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
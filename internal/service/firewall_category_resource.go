@@ -4,13 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -40,16 +39,16 @@ func (r *FirewallCategoryResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *FirewallCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
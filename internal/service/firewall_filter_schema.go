@@ -195,7 +195,7 @@ func FirewallFilterResourceSchema() schema.Schema {
 				},
 			},
 			"gateway": schema.StringAttribute{
-				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway to utilize policy based routing. Defaults to `\"\"`.",
+				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway or gateway group (by name, e.g. `WAN_FAILOVER`) to utilize policy based routing. Gateway groups are not exposed through the OPNsense API and must be created in the web GUI. Defaults to `\"\"`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
@@ -298,7 +298,7 @@ func FirewallFilterDataSourceSchema() dschema.Schema {
 				},
 			},
 			"gateway": dschema.StringAttribute{
-				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway to utilize policy based routing.",
+				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway or gateway group to utilize policy based routing.",
 				Computed:            true,
 			},
 			"log": dschema.BoolAttribute{
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KeaDHCPv4Resource{}
+var _ resource.ResourceWithImportState = &KeaDHCPv4Resource{}
+var _ resource.ResourceWithValidateConfig = &KeaDHCPv4Resource{}
+
+func NewKeaDHCPv4Resource() resource.Resource {
+	return &KeaDHCPv4Resource{}
+}
+
+// KeaDHCPv4Resource defines the resource implementation.
+type KeaDHCPv4Resource struct {
+	client opnsense.Client
+}
+
+func (r *KeaDHCPv4Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kea_dhcpv4"
+}
+
+func (r *KeaDHCPv4Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = keaDHCPv4ResourceSchema()
+}
+
+func (r *KeaDHCPv4Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KeaDHCPv4Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *KeaDHCPv4ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	settings, err := convertKeaDHCPv4SchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+
+	// Apply kea dhcpv4 settings to kea
+	err = r.client.Kea().UpdateDHCPv4Settings(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+
+	// Settings are a singleton, so use a fixed ID
+	data.Id = types.StringValue(keaDHCPv4Id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeaDHCPv4Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *KeaDHCPv4ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get kea dhcpv4 settings from OPNsense kea API
+	settings, err := r.client.Kea().GetDHCPv4Settings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	settingsModel, err := convertKeaDHCPv4StructToSchema(settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	settingsModel.Id = types.StringValue(keaDHCPv4Id)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settingsModel)...)
+}
+
+func (r *KeaDHCPv4Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *KeaDHCPv4ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	settings, err := convertKeaDHCPv4SchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+
+	// Apply kea dhcpv4 settings to kea
+	err = r.client.Kea().UpdateDHCPv4Settings(ctx, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeaDHCPv4Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *KeaDHCPv4ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings cannot be removed, reset them to their defaults instead
+	err := r.client.Kea().UpdateDHCPv4Settings(ctx, keaDHCPv4Defaults())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to reset kea dhcpv4 settings, got error: %s", err))
+		return
+	}
+}
+
+func (r *KeaDHCPv4Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *KeaDHCPv4ResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.HAEnabled.IsUnknown() || data.ControlAgentEnabled.IsUnknown() {
+		return
+	}
+
+	// The HA peers synchronise their leases through the control agent
+	if data.HAEnabled.ValueBool() && !data.ControlAgentEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("control_agent_enabled"), "Missing Control Agent",
+			"Attribute control_agent_enabled must be true when ha_enabled is true.")
+	}
+}
+
+func (r *KeaDHCPv4Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// keaDHCPv4Id is the ID of the Kea DHCPv4 singleton resource.
+const keaDHCPv4Id = "dhcpv4"
+
+// KeaDHCPv4ResourceModel describes the resource data model.
+type KeaDHCPv4ResourceModel struct {
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	Interfaces          types.Set    `tfsdk:"interfaces"`
+	ValidLifetime       types.Int64  `tfsdk:"valid_lifetime"`
+	FirewallRules       types.Bool   `tfsdk:"firewall_rules"`
+	HAEnabled           types.Bool   `tfsdk:"ha_enabled"`
+	HAThisServerName    types.String `tfsdk:"ha_this_server_name"`
+	HAMaxUnackedClients types.Int64  `tfsdk:"ha_max_unacked_clients"`
+	ControlAgentEnabled types.Bool   `tfsdk:"control_agent_enabled"`
+	ControlAgentHost    types.String `tfsdk:"control_agent_host"`
+	ControlAgentPort    types.Int64  `tfsdk:"control_agent_port"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaDHCPv4ResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the Kea DHCPv4 service and the control agent used for HA. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the Kea DHCPv4 service. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces to serve DHCP requests on. This uses identifiers like `lan` or `opt2`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Lifetime in seconds of the leases handed out. Defaults to `4000`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(4000),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"firewall_rules": schema.BoolAttribute{
+				MarkdownDescription: "Automatically add the firewall rules needed for DHCP on the listening interfaces. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ha_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable high availability with the peers configured using `opnsense_kea_peer`. Requires `control_agent_enabled`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ha_this_server_name": schema.StringAttribute{
+				MarkdownDescription: "The name of this server, which must match one of the peers. Uses the hostname when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"ha_max_unacked_clients": schema.Int64Attribute{
+				MarkdownDescription: "Number of clients the partner may leave unanswered before this server takes over. Set to `0` to take over as soon as the partner is unreachable. Defaults to `2`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(2),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"control_agent_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the Kea control agent, which the HA peers use to talk to each other. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"control_agent_host": schema.StringAttribute{
+				MarkdownDescription: "Address the control agent listens on. Defaults to `\"127.0.0.1\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("127.0.0.1"),
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"control_agent_port": schema.Int64Attribute{
+				MarkdownDescription: "Port the control agent listens on. Defaults to `8000`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(8000),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the Kea DHCPv4 settings, always `dhcpv4`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaDHCPv4DataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the Kea DHCPv4 service and the control agent used for HA.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the Kea DHCPv4 settings, always `dhcpv4`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the Kea DHCPv4 service is enabled.",
+				Computed:            true,
+			},
+			"interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces DHCP requests are served on.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"valid_lifetime": dschema.Int64Attribute{
+				MarkdownDescription: "Lifetime in seconds of the leases handed out.",
+				Computed:            true,
+			},
+			"firewall_rules": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the firewall rules needed for DHCP are added automatically.",
+				Computed:            true,
+			},
+			"ha_enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether high availability is enabled.",
+				Computed:            true,
+			},
+			"ha_this_server_name": dschema.StringAttribute{
+				MarkdownDescription: "The name of this server, the hostname when empty.",
+				Computed:            true,
+			},
+			"ha_max_unacked_clients": dschema.Int64Attribute{
+				MarkdownDescription: "Number of clients the partner may leave unanswered before this server takes over.",
+				Computed:            true,
+			},
+			"control_agent_enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the Kea control agent is enabled.",
+				Computed:            true,
+			},
+			"control_agent_host": dschema.StringAttribute{
+				MarkdownDescription: "Address the control agent listens on.",
+				Computed:            true,
+			},
+			"control_agent_port": dschema.Int64Attribute{
+				MarkdownDescription: "Port the control agent listens on.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// keaDHCPv4Defaults returns the Kea DHCPv4 settings of a fresh OPNsense install, used to reset the singleton.
+func keaDHCPv4Defaults() *kea.DHCPv4Settings {
+	return &kea.DHCPv4Settings{
+		DHCPv4: kea.DHCPv4{
+			General: kea.DHCPv4General{
+				Enabled:       "0",
+				Interfaces:    api.SelectedMapList{},
+				ValidLifetime: "4000",
+				FirewallRules: "1",
+			},
+			HA: kea.DHCPv4HA{
+				Enabled:           "0",
+				ThisServerName:    "",
+				MaxUnackedClients: "2",
+			},
+		},
+		CtrlAgent: kea.CtrlAgent{
+			General: kea.CtrlAgentGeneral{
+				Enabled:  "0",
+				HTTPHost: "127.0.0.1",
+				HTTPPort: "8000",
+			},
+		},
+	}
+}
+
+func convertKeaDHCPv4SchemaToStruct(d *KeaDHCPv4ResourceModel) (*kea.DHCPv4Settings, error) {
+	return &kea.DHCPv4Settings{
+		DHCPv4: kea.DHCPv4{
+			General: kea.DHCPv4General{
+				Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+				Interfaces:    tools.SetToStringSlice(d.Interfaces),
+				ValidLifetime: tools.Int64ToString(d.ValidLifetime.ValueInt64()),
+				FirewallRules: tools.BoolToString(d.FirewallRules.ValueBool()),
+			},
+			HA: kea.DHCPv4HA{
+				Enabled:           tools.BoolToString(d.HAEnabled.ValueBool()),
+				ThisServerName:    d.HAThisServerName.ValueString(),
+				MaxUnackedClients: tools.Int64ToString(d.HAMaxUnackedClients.ValueInt64()),
+			},
+		},
+		CtrlAgent: kea.CtrlAgent{
+			General: kea.CtrlAgentGeneral{
+				Enabled:  tools.BoolToString(d.ControlAgentEnabled.ValueBool()),
+				HTTPHost: d.ControlAgentHost.ValueString(),
+				HTTPPort: tools.Int64ToString(d.ControlAgentPort.ValueInt64()),
+			},
+		},
+	}, nil
+}
+
+func convertKeaDHCPv4StructToSchema(d *kea.DHCPv4Settings) (*KeaDHCPv4ResourceModel, error) {
+	return &KeaDHCPv4ResourceModel{
+		Enabled:             types.BoolValue(tools.StringToBool(d.DHCPv4.General.Enabled)),
+		Interfaces:          tools.StringSliceToSet(d.DHCPv4.General.Interfaces),
+		ValidLifetime:       types.Int64Value(tools.StringToInt64(d.DHCPv4.General.ValidLifetime)),
+		FirewallRules:       types.BoolValue(tools.StringToBool(d.DHCPv4.General.FirewallRules)),
+		HAEnabled:           types.BoolValue(tools.StringToBool(d.DHCPv4.HA.Enabled)),
+		HAThisServerName:    types.StringValue(d.DHCPv4.HA.ThisServerName),
+		HAMaxUnackedClients: types.Int64Value(tools.StringToInt64(d.DHCPv4.HA.MaxUnackedClients)),
+		ControlAgentEnabled: types.BoolValue(tools.StringToBool(d.CtrlAgent.General.Enabled)),
+		ControlAgentHost:    types.StringValue(d.CtrlAgent.General.HTTPHost),
+		ControlAgentPort:    types.Int64Value(tools.StringToInt64(d.CtrlAgent.General.HTTPPort)),
+	}, nil
+}
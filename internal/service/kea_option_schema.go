@@ -0,0 +1,440 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// keaOptionTypes are the data types an option can be declared with.
+var keaOptionTypes = []string{"ip", "ip-list", "string", "hex", "uint8", "uint16", "uint32", "boolean"}
+
+// KeaOptionResourceModel describes the resource data model.
+type KeaOptionResourceModel struct {
+	Code        types.Int64  `tfsdk:"code"`
+	Space       types.String `tfsdk:"space"`
+	Type        types.String `tfsdk:"type"`
+	Data        types.String `tfsdk:"data"`
+	AlwaysSend  types.Bool   `tfsdk:"always_send"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// KeaOptionModel describes an element of the options block of subnets and reservations.
+type KeaOptionModel struct {
+	Code       types.Int64  `tfsdk:"code"`
+	Space      types.String `tfsdk:"space"`
+	Type       types.String `tfsdk:"type"`
+	Data       types.String `tfsdk:"data"`
+	AlwaysSend types.Bool   `tfsdk:"always_send"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var keaOptionAttrTypes = map[string]attr.Type{
+	"code":        types.Int64Type,
+	"space":       types.StringType,
+	"type":        types.StringType,
+	"data":        types.StringType,
+	"always_send": types.BoolType,
+	"id":          types.StringType,
+}
+
+func keaOptionResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure custom DHCPv4 options for Kea, sent to the clients of all subnets. Use the `options` block of `opnsense_kea_subnet` or `opnsense_kea_reservation` to scope an option to a subnet or reservation.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.Int64Attribute{
+				MarkdownDescription: "The option code (e.g. `66` for the TFTP server name).",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 254),
+				},
+			},
+			"space": schema.StringAttribute{
+				MarkdownDescription: "The option space. Use e.g. `vendor-encapsulated-options-space` for sub-options of option `43`. Defaults to `\"dhcp4\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("dhcp4"),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The data type of the option, one of `ip`, `ip-list`, `string`, `hex`, `uint8`, `uint16`, `uint32` or `boolean`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(keaOptionTypes...),
+				},
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "The value of the option, which must match `type` (e.g. `\"192.0.2.1,192.0.2.2\"` for `ip-list` or `\"0a:0b\"` for `hex`).",
+				Required:            true,
+				Validators: []validator.String{
+					validators.KeaOptionData(),
+				},
+			},
+			"always_send": schema.BoolAttribute{
+				MarkdownDescription: "Send the option even when the client does not request it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the option.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaOptionDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure custom DHCPv4 options for Kea, sent to the clients of all subnets.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the option.",
+				Required:            true,
+			},
+			"code": dschema.Int64Attribute{
+				MarkdownDescription: "The option code.",
+				Computed:            true,
+			},
+			"space": dschema.StringAttribute{
+				MarkdownDescription: "The option space.",
+				Computed:            true,
+			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "The data type of the option.",
+				Computed:            true,
+			},
+			"data": dschema.StringAttribute{
+				MarkdownDescription: "The value of the option.",
+				Computed:            true,
+			},
+			"always_send": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the option is sent even when the client does not request it.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// keaOptionsResourceAttribute is the options block shared by subnets and reservations.
+func keaOptionsResourceAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Custom DHCP options sent to the clients, in addition to the fixed options above. Only the options created through this block are managed: other options scoped to the same subnet or reservation, such as those created in the web interface, are left alone and not shown here. Importing a subnet or reservation adopts all of its options. Defaults to `[]`, which removes the options created through this block.",
+		Optional:            true,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"code": schema.Int64Attribute{
+					MarkdownDescription: "The option code (e.g. `150` for TFTP servers of VoIP phones).",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 254),
+					},
+				},
+				"space": schema.StringAttribute{
+					MarkdownDescription: "The option space. Defaults to `\"dhcp4\"`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("dhcp4"),
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The data type of the option, one of `ip`, `ip-list`, `string`, `hex`, `uint8`, `uint16`, `uint32` or `boolean`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(keaOptionTypes...),
+					},
+				},
+				"data": schema.StringAttribute{
+					MarkdownDescription: "The value of the option, which must match `type`.",
+					Required:            true,
+					Validators: []validator.String{
+						validators.KeaOptionData(),
+					},
+				},
+				"always_send": schema.BoolAttribute{
+					MarkdownDescription: "Send the option even when the client does not request it. Defaults to `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"id": schema.StringAttribute{
+					MarkdownDescription: "UUID of the option.",
+					Computed:            true,
+				},
+			},
+		},
+		Default: setdefault.StaticValue(
+			tools.EmptySetValue(
+				types.ObjectType{
+					AttrTypes: keaOptionAttrTypes,
+				},
+			),
+		),
+	}
+}
+
+func keaOptionsDataSourceAttribute() dschema.SetNestedAttribute {
+	return dschema.SetNestedAttribute{
+		MarkdownDescription: "Custom DHCP options sent to the clients.",
+		Computed:            true,
+		NestedObject: dschema.NestedAttributeObject{
+			Attributes: map[string]dschema.Attribute{
+				"code": dschema.Int64Attribute{
+					MarkdownDescription: "The option code.",
+					Computed:            true,
+				},
+				"space": dschema.StringAttribute{
+					MarkdownDescription: "The option space.",
+					Computed:            true,
+				},
+				"type": dschema.StringAttribute{
+					MarkdownDescription: "The data type of the option.",
+					Computed:            true,
+				},
+				"data": dschema.StringAttribute{
+					MarkdownDescription: "The value of the option.",
+					Computed:            true,
+				},
+				"always_send": dschema.BoolAttribute{
+					MarkdownDescription: "Whether the option is sent even when the client does not request it.",
+					Computed:            true,
+				},
+				"id": dschema.StringAttribute{
+					MarkdownDescription: "UUID of the option.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func convertKeaOptionSchemaToStruct(d *KeaOptionResourceModel) (*kea.Option, error) {
+	return &kea.Option{
+		Code:        tools.Int64ToString(d.Code.ValueInt64()),
+		Space:       d.Space.ValueString(),
+		Type:        api.SelectedMap(d.Type.ValueString()),
+		Data:        d.Data.ValueString(),
+		AlwaysSend:  tools.BoolToString(d.AlwaysSend.ValueBool()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertKeaOptionStructToSchema(d *kea.Option) (*KeaOptionResourceModel, error) {
+	return &KeaOptionResourceModel{
+		Code:        types.Int64Value(tools.StringToInt64(d.Code)),
+		Space:       types.StringValue(d.Space),
+		Type:        types.StringValue(d.Type.String()),
+		Data:        types.StringValue(d.Data),
+		AlwaysSend:  types.BoolValue(tools.StringToBool(d.AlwaysSend)),
+		Description: types.StringValue(d.Description),
+	}, nil
+}
+
+func convertKeaOptionsSchemaToStruct(options types.Set) []*kea.Option {
+	var optionList []KeaOptionModel
+	options.ElementsAs(context.Background(), &optionList, false)
+
+	var res []*kea.Option
+	for _, option := range optionList {
+		res = append(res, &kea.Option{
+			Code:       tools.Int64ToString(option.Code.ValueInt64()),
+			Space:      option.Space.ValueString(),
+			Type:       api.SelectedMap(option.Type.ValueString()),
+			Data:       option.Data.ValueString(),
+			AlwaysSend: tools.BoolToString(option.AlwaysSend.ValueBool()),
+		})
+	}
+	return res
+}
+
+func keaOptionModelFromStruct(option *kea.Option) KeaOptionModel {
+	return KeaOptionModel{
+		Code:       types.Int64Value(tools.StringToInt64(option.Code)),
+		Space:      types.StringValue(option.Space),
+		Type:       types.StringValue(option.Type.String()),
+		Data:       types.StringValue(option.Data),
+		AlwaysSend: types.BoolValue(tools.StringToBool(option.AlwaysSend)),
+		Id:         types.StringNull(),
+	}
+}
+
+func convertKeaOptionsStructToSchema(ids []string, options []*kea.Option) types.Set {
+	optionList := []KeaOptionModel{}
+	for i, option := range options {
+		model := keaOptionModelFromStruct(option)
+		model.Id = types.StringValue(ids[i])
+		optionList = append(optionList, model)
+	}
+
+	v, _ := types.SetValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: keaOptionAttrTypes,
+		},
+		optionList,
+	)
+	return v
+}
+
+// keaOptionKey identifies the content of an element of an options block, or returns "" if it is not yet known.
+func keaOptionKey(option KeaOptionModel) string {
+	if option.Code.IsUnknown() || option.Space.IsUnknown() || option.Type.IsUnknown() || option.Data.IsUnknown() || option.AlwaysSend.IsUnknown() {
+		return ""
+	}
+	return fmt.Sprintf("%d|%s|%s|%s|%t", option.Code.ValueInt64(), option.Space.ValueString(), option.Type.ValueString(), option.Data.ValueString(), option.AlwaysSend.ValueBool())
+}
+
+// keaOptionIDs returns the UUID recorded for each element of an options block, in the order of
+// convertKeaOptionsSchemaToStruct, or "" for elements without one.
+func keaOptionIDs(options types.Set) []string {
+	var optionList []KeaOptionModel
+	options.ElementsAs(context.Background(), &optionList, false)
+
+	ids := make([]string, len(optionList))
+	for i, option := range optionList {
+		if !option.Id.IsNull() && !option.Id.IsUnknown() {
+			ids[i] = option.Id.ValueString()
+		}
+	}
+	return ids
+}
+
+// keaOwnedOptionIDs returns the UUIDs of the options created through an options block, as recorded in its elements.
+func keaOwnedOptionIDs(options types.Set) []string {
+	var ids []string
+	for _, id := range keaOptionIDs(options) {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// setKeaOptionIDs records the UUID of the option backing each element of an options block.
+func setKeaOptionIDs(options types.Set, ids []string) types.Set {
+	var optionList []KeaOptionModel
+	options.ElementsAs(context.Background(), &optionList, false)
+
+	for i := range optionList {
+		optionList[i].Id = types.StringValue(ids[i])
+	}
+
+	v, _ := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: keaOptionAttrTypes}, optionList)
+	return v
+}
+
+// planKeaOptionIDs plans the UUID of each element of an options block. Elements whose content is unchanged keep
+// the option they had in the prior state, the UUIDs of the others are only known after apply.
+func planKeaOptionIDs(ctx context.Context, plan types.Set, state types.Set) (types.Set, diag.Diagnostics) {
+	if plan.IsNull() || plan.IsUnknown() {
+		return plan, nil
+	}
+
+	var diags diag.Diagnostics
+	var planList, stateList []KeaOptionModel
+	diags.Append(plan.ElementsAs(ctx, &planList, false)...)
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.ElementsAs(ctx, &stateList, false)...)
+	}
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	prior := map[string][]types.String{}
+	for _, option := range stateList {
+		key := keaOptionKey(option)
+		prior[key] = append(prior[key], option.Id)
+	}
+
+	for i, option := range planList {
+		key := keaOptionKey(option)
+		if key != "" && len(prior[key]) > 0 && !prior[key][0].IsNull() {
+			planList[i].Id = prior[key][0]
+			prior[key] = prior[key][1:]
+			continue
+		}
+		planList[i].Id = types.StringUnknown()
+	}
+
+	v, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: keaOptionAttrTypes}, planList)
+	diags.Append(d...)
+	return v, diags
+}
+
+// readKeaOwnedOptions returns the UUIDs and options of a subnet or reservation which were created through its options
+// block, as recorded in state. Elements without a UUID, from before they were recorded, adopt a scoped option with
+// the same content. An imported subnet or reservation, which has no options in state yet, adopts all scoped options.
+func readKeaOwnedOptions(ctx context.Context, c *kea.Controller, subnet string, reservation string, state types.Set) ([]string, []*kea.Option, error) {
+	scopedIds, scoped, err := c.GetScopedOptions(ctx, subnet, reservation)
+	if err != nil {
+		return nil, nil, err
+	}
+	if state.IsNull() {
+		return scopedIds, scoped, nil
+	}
+
+	var stateList []KeaOptionModel
+	state.ElementsAs(ctx, &stateList, false)
+
+	owned := map[string]bool{}
+	for _, option := range stateList {
+		if !option.Id.IsNull() && !option.Id.IsUnknown() {
+			owned[option.Id.ValueString()] = true
+		}
+	}
+	for _, option := range stateList {
+		if !option.Id.IsNull() && !option.Id.IsUnknown() {
+			continue
+		}
+		key := keaOptionKey(option)
+		for i, id := range scopedIds {
+			if !owned[id] && keaOptionKey(keaOptionModelFromStruct(scoped[i])) == key {
+				owned[id] = true
+				break
+			}
+		}
+	}
+
+	var ids []string
+	var options []*kea.Option
+	for i, id := range scopedIds {
+		if owned[id] {
+			ids = append(ids, id)
+			options = append(options, scoped[i])
+		}
+	}
+	return ids, options, nil
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KeaPDPoolResource{}
+var _ resource.ResourceWithImportState = &KeaPDPoolResource{}
+var _ resource.ResourceWithValidateConfig = &KeaPDPoolResource{}
+
+func NewKeaPDPoolResource() resource.Resource {
+	return &KeaPDPoolResource{}
+}
+
+// KeaPDPoolResource defines the resource implementation.
+type KeaPDPoolResource struct {
+	client opnsense.Client
+}
+
+func (r *KeaPDPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kea_pd_pool"
+}
+
+func (r *KeaPDPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = keaPDPoolResourceSchema()
+}
+
+func (r *KeaPDPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KeaPDPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *KeaPDPoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	pdPool, err := convertKeaPDPoolSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse prefix delegation pool, got error: %s", err))
+		return
+	}
+
+	// Add prefix delegation pool to kea
+	id, err := r.client.Kea().AddPDPool(ctx, pdPool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create prefix delegation pool, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeaPDPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *KeaPDPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get prefix delegation pool from OPNsense kea API
+	pdPool, err := r.client.Kea().GetPDPool(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("prefix delegation pool not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read prefix delegation pool, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	pdPoolModel, err := convertKeaPDPoolStructToSchema(pdPool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read prefix delegation pool, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	pdPoolModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &pdPoolModel)...)
+}
+
+func (r *KeaPDPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *KeaPDPoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	pdPool, err := convertKeaPDPoolSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse prefix delegation pool, got error: %s", err))
+		return
+	}
+
+	// Update prefix delegation pool in kea
+	err = r.client.Kea().UpdatePDPool(ctx, data.Id.ValueString(), pdPool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create prefix delegation pool, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeaPDPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *KeaPDPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Kea().DeletePDPool(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete prefix delegation pool, got error: %s", err))
+		return
+	}
+}
+
+func (r *KeaPDPoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *KeaPDPoolResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.PrefixLength.IsUnknown() || data.DelegatedLength.IsUnknown() {
+		return
+	}
+
+	// Delegated prefixes are carved out of the pool prefix, so they cannot be larger
+	if data.DelegatedLength.ValueInt64() < data.PrefixLength.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("delegated_length"), "Invalid Delegated Length",
+			fmt.Sprintf("Attribute delegated_length must be at least prefix_length (%d), got: %d.",
+				data.PrefixLength.ValueInt64(), data.DelegatedLength.ValueInt64()))
+	}
+}
+
+func (r *KeaPDPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// KeaPDPoolResourceModel describes the resource data model.
+type KeaPDPoolResourceModel struct {
+	SubnetId types.String `tfsdk:"subnet_id"`
+
+	Prefix          types.String `tfsdk:"prefix"`
+	PrefixLength    types.Int64  `tfsdk:"prefix_length"`
+	DelegatedLength types.Int64  `tfsdk:"delegated_length"`
+
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaPDPoolResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure DHCPv6 prefix delegation pools for Kea. Clients such as downstream routers request a prefix of `delegated_length` out of the pool.",
+
+		Attributes: map[string]schema.Attribute{
+			"subnet_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `opnsense_kea_subnet6` the pool belongs to.",
+				Required:            true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the pool, without length (e.g. `\"2001:db8:100::\"`).",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				MarkdownDescription: "Length of the prefix of the pool (e.g. `56`).",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+			},
+			"delegated_length": schema.Int64Attribute{
+				MarkdownDescription: "Length of the prefixes delegated to the clients (e.g. `64`). Must be at least `prefix_length`.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the prefix delegation pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaPDPoolDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure DHCPv6 prefix delegation pools for Kea.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the prefix delegation pool.",
+				Required:            true,
+			},
+			"subnet_id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the subnet the pool belongs to.",
+				Computed:            true,
+			},
+			"prefix": dschema.StringAttribute{
+				MarkdownDescription: "The prefix of the pool, without length.",
+				Computed:            true,
+			},
+			"prefix_length": dschema.Int64Attribute{
+				MarkdownDescription: "Length of the prefix of the pool.",
+				Computed:            true,
+			},
+			"delegated_length": dschema.Int64Attribute{
+				MarkdownDescription: "Length of the prefixes delegated to the clients.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertKeaPDPoolSchemaToStruct(d *KeaPDPoolResourceModel) (*kea.PDPool, error) {
+	return &kea.PDPool{
+		Subnet:          api.SelectedMap(d.SubnetId.ValueString()),
+		Prefix:          d.Prefix.ValueString(),
+		PrefixLength:    tools.Int64ToString(d.PrefixLength.ValueInt64()),
+		DelegatedLength: tools.Int64ToString(d.DelegatedLength.ValueInt64()),
+		Description:     d.Description.ValueString(),
+	}, nil
+}
+
+func convertKeaPDPoolStructToSchema(d *kea.PDPool) (*KeaPDPoolResourceModel, error) {
+	return &KeaPDPoolResourceModel{
+		SubnetId:        types.StringValue(d.Subnet.String()),
+		Prefix:          types.StringValue(d.Prefix),
+		PrefixLength:    types.Int64Value(tools.StringToInt64(d.PrefixLength)),
+		DelegatedLength: types.Int64Value(tools.StringToInt64(d.DelegatedLength)),
+		Description:     types.StringValue(d.Description),
+	}, nil
+}
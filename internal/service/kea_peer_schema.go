@@ -28,7 +28,7 @@ func KeaPeerResourceSchema() schema.Schema {
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Peer name, there should be one entry matching this machine's \"This server name\".",
+				MarkdownDescription: "Peer name, there should be one entry matching `ha_this_server_name` of `opnsense_kea_dhcpv4`.",
 				Required:            true,
 			},
 			"url": schema.StringAttribute{
@@ -65,7 +65,7 @@ func KeaPeerDataSourceSchema() dschema.Schema {
 				Required:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Peer name, there should be one entry matching this machine's \"This server name\".",
+				MarkdownDescription: "Peer name, there should be one entry matching `ha_this_server_name` of `opnsense_kea_dhcpv4`.",
 				Computed:            true,
 			},
 			"url": schema.StringAttribute{
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// KeaReservation6ResourceModel describes the resource data model.
+type KeaReservation6ResourceModel struct {
+	SubnetId types.String `tfsdk:"subnet_id"`
+
+	IpAddress    types.String `tfsdk:"ip_address"`
+	DUID         types.String `tfsdk:"duid"`
+	Hostname     types.String `tfsdk:"hostname"`
+	DomainSearch types.Set    `tfsdk:"domain_search"`
+
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaReservation6ResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure DHCPv6 reservations for Kea. Clients are identified by their DUID.",
+
+		Attributes: map[string]schema.Attribute{
+			"subnet_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `opnsense_kea_subnet6` the reservation belongs to.",
+				Required:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IPv6 address to offer to the client.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
+			},
+			"duid": schema.StringAttribute{
+				MarkdownDescription: "DUID of the client in question, as colon separated hex bytes (e.g. `\"00:01:00:01:2b:3c:4d:5e:00:25:96:12:34:56\"`).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9a-fA-F]{2}(:[0-9a-fA-F]{2})+$`),
+						"must be colon separated hex bytes",
+					),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to offer to the client. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"domain_search": schema.SetAttribute{
+				MarkdownDescription: "Set of Domain Names to be used by the client, overriding the ones of the subnet. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the reservation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaReservation6DataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure DHCPv6 reservations for Kea.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the reservation.",
+				Required:            true,
+			},
+			"subnet_id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the subnet the reservation belongs to.",
+				Computed:            true,
+			},
+			"ip_address": dschema.StringAttribute{
+				MarkdownDescription: "IPv6 address to offer to the client.",
+				Computed:            true,
+			},
+			"duid": dschema.StringAttribute{
+				MarkdownDescription: "DUID of the client in question.",
+				Computed:            true,
+			},
+			"hostname": dschema.StringAttribute{
+				MarkdownDescription: "Hostname to offer to the client.",
+				Computed:            true,
+			},
+			"domain_search": dschema.SetAttribute{
+				MarkdownDescription: "Set of Domain Names to be used by the client.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertKeaReservation6SchemaToStruct(d *KeaReservation6ResourceModel) (*kea.Reservation6, error) {
+	return &kea.Reservation6{
+		Subnet:       api.SelectedMap(d.SubnetId.ValueString()),
+		IpAddress:    d.IpAddress.ValueString(),
+		DUID:         d.DUID.ValueString(),
+		Hostname:     d.Hostname.ValueString(),
+		DomainSearch: tools.SetToStringSlice(d.DomainSearch),
+		Description:  d.Description.ValueString(),
+	}, nil
+}
+
+func convertKeaReservation6StructToSchema(d *kea.Reservation6) (*KeaReservation6ResourceModel, error) {
+	return &KeaReservation6ResourceModel{
+		SubnetId:     types.StringValue(d.Subnet.String()),
+		IpAddress:    types.StringValue(d.IpAddress),
+		DUID:         types.StringValue(d.DUID),
+		Hostname:     types.StringValue(d.Hostname),
+		DomainSearch: tools.StringSliceToSet(d.DomainSearch),
+		Description:  types.StringValue(d.Description),
+	}, nil
+}
@@ -19,6 +19,8 @@ type KeaReservationResourceModel struct {
 	MacAddress types.String `tfsdk:"mac_address"`
 	Hostname   types.String `tfsdk:"hostname"`
 
+	Options types.Set `tfsdk:"options"`
+
 	Description types.String `tfsdk:"description"`
 
 	Id types.String `tfsdk:"id"`
@@ -47,6 +49,7 @@ func KeaReservationResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"options": keaOptionsResourceAttribute(),
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Optional:            true,
@@ -89,6 +92,7 @@ func KeaReservationDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Hostname to offer to the client.",
 				Computed:            true,
 			},
+			"options": keaOptionsDataSourceAttribute(),
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Computed:            true,
@@ -113,6 +117,7 @@ func convertKeaReservationStructToSchema(d *kea.Reservation) (*KeaReservationRes
 		IpAddress:   types.StringValue(d.IpAddress),
 		MacAddress:  types.StringValue(d.HwAddress),
 		Hostname:    types.StringValue(d.Hostname),
+		Options:     convertKeaOptionsStructToSchema(nil, nil),
 		Description: types.StringValue(d.Description),
 	}, nil
 }
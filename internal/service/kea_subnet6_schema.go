@@ -0,0 +1,153 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// KeaSubnet6ResourceModel describes the resource data model.
+type KeaSubnet6ResourceModel struct {
+	Subnet    types.String `tfsdk:"subnet"`
+	Interface types.String `tfsdk:"interface"`
+	Pools     types.Set    `tfsdk:"pools"`
+
+	DomainNameServers types.Set `tfsdk:"dns_servers"`
+	DomainSearch      types.Set `tfsdk:"domain_search"`
+
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaSubnet6ResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure DHCPv6 subnets for Kea. Prefix delegation pools and reservations are added with `opnsense_kea_pd_pool` and `opnsense_kea_reservation6`.",
+
+		Attributes: map[string]schema.Attribute{
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "Subnet to use (e.g. `\"2001:db8:1::/64\"`), should be large enough to hold the specified pools and reservations.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IPv6CIDR(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface the subnet is served on. This uses an identifier like `lan` or `opt2`.",
+				Required:            true,
+			},
+			"pools": schema.SetAttribute{
+				MarkdownDescription: "Set of address pools in range or subnet format (e.g. `\"2001:db8:1::1000 - 2001:db8:1::1fff\"`, `\"2001:db8:1::/112\"`). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"dns_servers": schema.SetAttribute{
+				MarkdownDescription: "DNS servers to offer to the clients. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IPv6Address()),
+				},
+			},
+			"domain_search": schema.SetAttribute{
+				MarkdownDescription: "Set of Domain Names to be used by the client to locate not-fully-qualified domain names. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the subnet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaSubnet6DataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure DHCPv6 subnets for Kea.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the subnet.",
+				Required:            true,
+			},
+			"subnet": dschema.StringAttribute{
+				MarkdownDescription: "Subnet in use (e.g. `\"2001:db8:1::/64\"`).",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface the subnet is served on.",
+				Computed:            true,
+			},
+			"pools": dschema.SetAttribute{
+				MarkdownDescription: "Set of address pools in range or subnet format.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_servers": dschema.SetAttribute{
+				MarkdownDescription: "DNS servers to offer to the clients.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"domain_search": dschema.SetAttribute{
+				MarkdownDescription: "Set of Domain Names to be used by the client to locate not-fully-qualified domain names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertKeaSubnet6SchemaToStruct(d *KeaSubnet6ResourceModel) (*kea.Subnet6, error) {
+	return &kea.Subnet6{
+		Subnet:    d.Subnet.ValueString(),
+		Interface: api.SelectedMap(d.Interface.ValueString()),
+		Pools:     tools.SetToString(d.Pools, "\n"),
+		OptionData: kea.OptionData6{
+			DomainNameServers: tools.SetToStringSlice(d.DomainNameServers),
+			DomainSearch:      tools.SetToStringSlice(d.DomainSearch),
+		},
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertKeaSubnet6StructToSchema(d *kea.Subnet6) (*KeaSubnet6ResourceModel, error) {
+	return &KeaSubnet6ResourceModel{
+		Subnet:            types.StringValue(d.Subnet),
+		Interface:         types.StringValue(d.Interface.String()),
+		Pools:             tools.StringSliceToSet(strings.Split(d.Pools, "\n")),
+		DomainNameServers: tools.StringSliceToSet(d.OptionData.DomainNameServers),
+		DomainSearch:      tools.StringSliceToSet(d.OptionData.DomainSearch),
+		Description:       types.StringValue(d.Description),
+	}, nil
+}
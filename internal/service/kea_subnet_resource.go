@@ -4,18 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &KeaSubnetResource{}
 var _ resource.ResourceWithImportState = &KeaSubnetResource{}
+var _ resource.ResourceWithModifyPlan = &KeaSubnetResource{}
 
 func NewKeaSubnetResource() resource.Resource {
 	return &KeaSubnetResource{}
@@ -40,16 +40,16 @@ func (r *KeaSubnetResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *KeaSubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -81,6 +81,22 @@ func (r *KeaSubnetResource) Create(ctx context.Context, req resource.CreateReque
 	// Tag new resource with ID from OPNsense
 	data.Id = types.StringValue(id)
 
+	// Track the subnet before adding its options, so it is not orphaned if that fails. The resource is
+	// then tainted and its options are read back on the next refresh.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the custom options of the subnet
+	optionIds, err := r.client.Kea().SetOwnedOptions(ctx, id, "", nil, convertKeaOptionsSchemaToStruct(data.Options), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create subnet options, got error: %s", err))
+		return
+	}
+	data.Options = setKeaOptionIDs(data.Options, optionIds)
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a resource")
 
@@ -124,15 +140,26 @@ func (r *KeaSubnetResource) Read(ctx context.Context, req resource.ReadRequest,
 	// ID cannot be added by convert... func, have to add here
 	resModel.Id = data.Id
 
+	// Custom options are separate items referring to the subnet, only those created by this resource are read
+	optionIds, options, err := readKeaOwnedOptions(ctx, r.client.Kea(), data.Id.ValueString(), "", data.Options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read subnet options, got error: %s", err))
+		return
+	}
+	resModel.Options = convertKeaOptionsStructToSchema(optionIds, options)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resModel)...)
 }
 
 func (r *KeaSubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *KeaSubnetResourceModel
+	var state *KeaSubnetResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -154,6 +181,15 @@ func (r *KeaSubnetResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// Update the custom options of the subnet
+	optionIds, err := r.client.Kea().SetOwnedOptions(ctx, data.Id.ValueString(), "", keaOwnedOptionIDs(state.Options), convertKeaOptionsSchemaToStruct(data.Options), keaOptionIDs(data.Options))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update subnet options, got error: %s", err))
+		return
+	}
+	data.Options = setKeaOptionIDs(data.Options, optionIds)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -168,7 +204,15 @@ func (r *KeaSubnetResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.Kea().DeleteSubnet(ctx, data.Id.ValueString())
+	// Options refer to the subnet, so the ones created by this resource have to be removed first
+	_, err := r.client.Kea().SetOwnedOptions(ctx, data.Id.ValueString(), "", keaOwnedOptionIDs(data.Options), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete subnet options, got error: %s", err))
+		return
+	}
+
+	err = r.client.Kea().DeleteSubnet(ctx, data.Id.ValueString())
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
@@ -177,6 +221,31 @@ func (r *KeaSubnetResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+func (r *KeaSubnetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy, and nothing to keep on create
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *KeaSubnetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unchanged options keep their UUID
+	options, diags := planKeaOptionIDs(ctx, plan.Options, state.Options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("options"), options)...)
+}
+
 func (r *KeaSubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
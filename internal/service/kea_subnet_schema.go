@@ -37,6 +37,8 @@ type KeaSubnetResourceModel struct {
 	TFPTServer   types.String `tfsdk:"tfpt_server"`
 	TFTPBootfile types.String `tfsdk:"tftp_bootfile"`
 
+	Options types.Set `tfsdk:"options"`
+
 	Description types.String `tfsdk:"description"`
 
 	Id types.String `tfsdk:"id"`
@@ -160,6 +162,7 @@ func KeaSubnetResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"options": keaOptionsResourceAttribute(),
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Optional:            true,
@@ -258,6 +261,7 @@ func KeaSubnetDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Boot filename to request.",
 				Computed:            true,
 			},
+			"options": keaOptionsDataSourceAttribute(),
 			"description": dschema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Computed:            true,
@@ -312,6 +316,7 @@ func convertKeaSubnetStructToSchema(d *kea.Subnet) (*KeaSubnetResourceModel, err
 		NextServer:        types.StringValue(d.NextServer),
 		TFPTServer:        types.StringValue(d.OptionData.TftpServerName),
 		TFTPBootfile:      types.StringValue(d.OptionData.BootFileName),
+		Options:           convertKeaOptionsStructToSchema(nil, nil),
 		Description:       types.StringValue(d.Description),
 	}
 
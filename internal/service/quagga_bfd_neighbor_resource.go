@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuaggaBFDNeighborResource{}
+var _ resource.ResourceWithImportState = &QuaggaBFDNeighborResource{}
+var _ resource.ResourceWithValidateConfig = &QuaggaBFDNeighborResource{}
+
+func NewQuaggaBFDNeighborResource() resource.Resource {
+	return &QuaggaBFDNeighborResource{}
+}
+
+// QuaggaBFDNeighborResource defines the resource implementation. It manages a BFD peer, which other
+// protocols (BGP neighbors, OSPF interfaces, static routes) track through their `bfd` option.
+type QuaggaBFDNeighborResource struct {
+	client opnsense.Client
+}
+
+func (r *QuaggaBFDNeighborResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bfd_neighbor"
+}
+
+func (r *QuaggaBFDNeighborResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = quaggaBFDNeighborResourceSchema()
+}
+
+func (r *QuaggaBFDNeighborResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QuaggaBFDNeighborResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *QuaggaBFDNeighborResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	bfdNeighbor, err := convertQuaggaBFDNeighborSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bfd neighbor, got error: %s", err))
+		return
+	}
+
+	// Add bfd neighbor to quagga
+	id, err := r.client.Quagga().AddBFDNeighbor(ctx, bfdNeighbor)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create bfd neighbor, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBFDNeighborResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *QuaggaBFDNeighborResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get bfd neighbor from OPNsense quagga API
+	bfdNeighbor, err := r.client.Quagga().GetBFDNeighbor(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("bfd neighbor not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bfd neighbor, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	bfdNeighborModel, err := convertQuaggaBFDNeighborStructToSchema(bfdNeighbor)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bfd neighbor, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	bfdNeighborModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bfdNeighborModel)...)
+}
+
+func (r *QuaggaBFDNeighborResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *QuaggaBFDNeighborResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	bfdNeighbor, err := convertQuaggaBFDNeighborSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bfd neighbor, got error: %s", err))
+		return
+	}
+
+	// Update bfd neighbor in quagga
+	err = r.client.Quagga().UpdateBFDNeighbor(ctx, data.Id.ValueString(), bfdNeighbor)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update bfd neighbor, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBFDNeighborResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *QuaggaBFDNeighborResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Quagga().DeleteBFDNeighbor(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete bfd neighbor, got error: %s", err))
+		return
+	}
+}
+
+func (r *QuaggaBFDNeighborResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *QuaggaBFDNeighborResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.MultiHop.ValueBool() {
+		return
+	}
+
+	// Multi-hop sessions are not bound to an interface, FRR needs the source address instead
+	if !data.LocalIP.IsUnknown() && data.LocalIP.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("local_ip"), "Missing Local IP",
+			"Attribute local_ip must be set when multi_hop is enabled.")
+	}
+	if !data.Interface.IsUnknown() && data.Interface.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("interface"), "Invalid Interface",
+			"Attribute interface cannot be set when multi_hop is enabled.")
+	}
+}
+
+func (r *QuaggaBFDNeighborResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
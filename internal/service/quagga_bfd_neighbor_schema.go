@@ -0,0 +1,194 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// QuaggaBFDNeighborResourceModel describes the resource data model.
+type QuaggaBFDNeighborResourceModel struct {
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Description      types.String `tfsdk:"description"`
+	PeerIP           types.String `tfsdk:"peer_ip"`
+	LocalIP          types.String `tfsdk:"local_ip"`
+	Interface        types.String `tfsdk:"interface"`
+	MultiHop         types.Bool   `tfsdk:"multi_hop"`
+	DetectMultiplier types.Int64  `tfsdk:"detect_multiplier"`
+	ReceiveInterval  types.Int64  `tfsdk:"receive_interval"`
+	TransmitInterval types.Int64  `tfsdk:"transmit_interval"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// quaggaBFDIntervalValidators restrict BFD packet intervals to the range accepted by FRR, in milliseconds.
+var quaggaBFDIntervalValidators = []validator.Int64{
+	int64validator.Between(10, 60000),
+}
+
+func quaggaBFDNeighborResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure BFD peers. A BFD session must exist for a peer before the `bfd` option of a BGP neighbor, OSPF interface or static route can track it.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this peer. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this peer. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"peer_ip": schema.StringAttribute{
+				MarkdownDescription: "The IP of the peer.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"local_ip": schema.StringAttribute{
+				MarkdownDescription: "The local IP to source BFD packets from. Required for multi-hop sessions. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface the peer is reached on. This uses an identifier like `lan` or `opt2`. Only valid for single-hop sessions. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"multi_hop": schema.BoolAttribute{
+				MarkdownDescription: "The peer is not directly connected. Requires `local_ip`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"detect_multiplier": schema.Int64Attribute{
+				MarkdownDescription: "Number of missed packets after which the session is declared down. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(2, 255),
+				},
+			},
+			"receive_interval": schema.Int64Attribute{
+				MarkdownDescription: "Minimum interval in milliseconds at which this system can receive control packets. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaBFDIntervalValidators,
+			},
+			"transmit_interval": schema.Int64Attribute{
+				MarkdownDescription: "Minimum interval in milliseconds at which this system wants to send control packets. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaBFDIntervalValidators,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the peer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaBFDNeighborDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure BFD peers.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the peer.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this peer is enabled.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this peer.",
+				Computed:            true,
+			},
+			"peer_ip": dschema.StringAttribute{
+				MarkdownDescription: "The IP of the peer.",
+				Computed:            true,
+			},
+			"local_ip": dschema.StringAttribute{
+				MarkdownDescription: "The local IP BFD packets are sourced from.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface the peer is reached on.",
+				Computed:            true,
+			},
+			"multi_hop": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the peer is not directly connected.",
+				Computed:            true,
+			},
+			"detect_multiplier": dschema.Int64Attribute{
+				MarkdownDescription: "Number of missed packets after which the session is declared down, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"receive_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Minimum receive interval in milliseconds, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"transmit_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Minimum transmit interval in milliseconds, `-1` if the default is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQuaggaBFDNeighborSchemaToStruct(d *QuaggaBFDNeighborResourceModel) (*quagga.BFDNeighbor, error) {
+	return &quagga.BFDNeighbor{
+		Enabled:          tools.BoolToString(d.Enabled.ValueBool()),
+		Description:      d.Description.ValueString(),
+		Address:          d.PeerIP.ValueString(),
+		LocalAddress:     d.LocalIP.ValueString(),
+		Interface:        api.SelectedMap(d.Interface.ValueString()),
+		MultiHop:         tools.BoolToString(d.MultiHop.ValueBool()),
+		DetectMultiplier: tools.Int64ToStringNegative(d.DetectMultiplier.ValueInt64()),
+		ReceiveInterval:  tools.Int64ToStringNegative(d.ReceiveInterval.ValueInt64()),
+		TransmitInterval: tools.Int64ToStringNegative(d.TransmitInterval.ValueInt64()),
+	}, nil
+}
+
+func convertQuaggaBFDNeighborStructToSchema(d *quagga.BFDNeighbor) (*QuaggaBFDNeighborResourceModel, error) {
+	return &QuaggaBFDNeighborResourceModel{
+		Enabled:          types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:      types.StringValue(d.Description),
+		PeerIP:           types.StringValue(d.Address),
+		LocalIP:          types.StringValue(d.LocalAddress),
+		Interface:        types.StringValue(d.Interface.String()),
+		MultiHop:         types.BoolValue(tools.StringToBool(d.MultiHop)),
+		DetectMultiplier: types.Int64Value(tools.StringToInt64(d.DetectMultiplier)),
+		ReceiveInterval:  types.Int64Value(tools.StringToInt64(d.ReceiveInterval)),
+		TransmitInterval: types.Int64Value(tools.StringToInt64(d.TransmitInterval)),
+	}, nil
+}
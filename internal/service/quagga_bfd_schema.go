@@ -0,0 +1,80 @@
+package service
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// quaggaBFDId is the ID of the BFD singleton resource.
+const quaggaBFDId = "bfd"
+
+// QuaggaBFDResourceModel describes the resource data model.
+type QuaggaBFDResourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func quaggaBFDResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of BFD (Bidirectional Forwarding Detection). BFD must be enabled for the `bfd` options of BGP neighbors, OSPF interfaces and static routes to have any effect. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable BFD. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the BFD settings, always `bfd`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaBFDDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of BFD (Bidirectional Forwarding Detection).",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the BFD settings, always `bfd`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether BFD is enabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// quaggaBFDDefaults returns the BFD settings of a fresh OPNsense install, used to reset the singleton.
+func quaggaBFDDefaults() *quagga.BFD {
+	return &quagga.BFD{
+		Enabled: "0",
+	}
+}
+
+func convertQuaggaBFDSchemaToStruct(d *QuaggaBFDResourceModel) (*quagga.BFD, error) {
+	return &quagga.BFD{
+		Enabled: tools.BoolToString(d.Enabled.ValueBool()),
+	}, nil
+}
+
+func convertQuaggaBFDStructToSchema(d *quagga.BFD) (*QuaggaBFDResourceModel, error) {
+	return &QuaggaBFDResourceModel{
+		Enabled: types.BoolValue(tools.StringToBool(d.Enabled)),
+	}, nil
+}
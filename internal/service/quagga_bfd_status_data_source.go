@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QuaggaBFDStatusDataSource{}
+
+func NewQuaggaBFDStatusDataSource() datasource.DataSource {
+	return &QuaggaBFDStatusDataSource{}
+}
+
+// QuaggaBFDStatusDataSource defines the data source implementation.
+type QuaggaBFDStatusDataSource struct {
+	client opnsense.Client
+}
+
+func (d *QuaggaBFDStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bfd_status"
+}
+
+func (d *QuaggaBFDStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = QuaggaBFDStatusDataSourceSchema()
+}
+
+func (d *QuaggaBFDStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QuaggaBFDStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *QuaggaBFDStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get resources from OPNsense API
+	resources, err := d.client.Quagga().GetBFDPeerAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bfd status, got error: %s", err))
+		return
+	}
+
+	// Match sessions to configured peers by peer IP. This is best effort, the
+	// summary is still useful without the neighbor IDs.
+	neighborIDs := map[string]string{}
+	neighbors, err := d.client.Quagga().GetBFDNeighborAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error",
+			fmt.Sprintf("Unable to read bfd neighbors, neighbor IDs will not be set, got error: %s", err))
+	}
+	for id, neighbor := range neighbors {
+		neighborIDs[neighbor.Address] = id
+	}
+
+	// Convert OPNsense struct to TF schema
+	model, err := convertQuaggaBFDStatusStructToSchema(resources, neighborIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bfd status, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type QuaggaBFDStatusDataSourceModel struct {
+	Sessions types.List `tfsdk:"sessions"`
+}
+
+type QuaggaBFDSessionModel struct {
+	PeerIP           types.String `tfsdk:"peer_ip"`
+	LocalIP          types.String `tfsdk:"local_ip"`
+	Interface        types.String `tfsdk:"interface"`
+	MultiHop         types.Bool   `tfsdk:"multi_hop"`
+	NeighborID       types.String `tfsdk:"neighbor_id"`
+	Status           types.String `tfsdk:"status"`
+	Uptime           types.Int64  `tfsdk:"uptime"`
+	Diagnostic       types.String `tfsdk:"diagnostic"`
+	RemoteDiagnostic types.String `tfsdk:"remote_diagnostic"`
+	DetectMultiplier types.Int64  `tfsdk:"detect_multiplier"`
+	ReceiveInterval  types.Int64  `tfsdk:"receive_interval"`
+	TransmitInterval types.Int64  `tfsdk:"transmit_interval"`
+}
+
+var quaggaBFDSessionAttrTypes = map[string]attr.Type{
+	"peer_ip":           types.StringType,
+	"local_ip":          types.StringType,
+	"interface":         types.StringType,
+	"multi_hop":         types.BoolType,
+	"neighbor_id":       types.StringType,
+	"status":            types.StringType,
+	"uptime":            types.Int64Type,
+	"diagnostic":        types.StringType,
+	"remote_diagnostic": types.StringType,
+	"detect_multiplier": types.Int64Type,
+	"receive_interval":  types.Int64Type,
+	"transmit_interval": types.Int64Type,
+}
+
+func QuaggaBFDStatusDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The BFD status can be used to get the runtime state of all BFD sessions, e.g. to verify in a `check` block that a session is `up`.",
+
+		Attributes: map[string]schema.Attribute{
+			"sessions": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all BFD sessions, including those requested dynamically by BGP, OSPF or static routes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"peer_ip": schema.StringAttribute{
+							MarkdownDescription: "The IP of the peer.",
+							Computed:            true,
+						},
+						"local_ip": schema.StringAttribute{
+							MarkdownDescription: "The local IP of the session.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Device the session is bound to, e.g. `vtnet1`. Empty for multi-hop sessions.",
+							Computed:            true,
+						},
+						"multi_hop": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is a multi-hop session.",
+							Computed:            true,
+						},
+						"neighbor_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the matching `opnsense_quagga_bfd_neighbor`. Null when the session was not configured as a BFD peer.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "State of the session, e.g. `up`, `down` or `init`.",
+							Computed:            true,
+						},
+						"uptime": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds the session has been up.",
+							Computed:            true,
+						},
+						"diagnostic": schema.StringAttribute{
+							MarkdownDescription: "Local diagnostic of the last state change, e.g. `ok` or `control detection time expired`.",
+							Computed:            true,
+						},
+						"remote_diagnostic": schema.StringAttribute{
+							MarkdownDescription: "Diagnostic of the last state change reported by the peer.",
+							Computed:            true,
+						},
+						"detect_multiplier": schema.Int64Attribute{
+							MarkdownDescription: "Negotiated detection multiplier.",
+							Computed:            true,
+						},
+						"receive_interval": schema.Int64Attribute{
+							MarkdownDescription: "Negotiated receive interval in milliseconds.",
+							Computed:            true,
+						},
+						"transmit_interval": schema.Int64Attribute{
+							MarkdownDescription: "Negotiated transmit interval in milliseconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertQuaggaBFDStatusStructToSchema(d []quagga.BFDPeer, neighborIDs map[string]string) (*QuaggaBFDStatusDataSourceModel, error) {
+	// Sort sessions by peer, so the list order is stable between reads
+	sort.SliceStable(d, func(i, j int) bool {
+		return d[i].Peer < d[j].Peer
+	})
+
+	sessions := []QuaggaBFDSessionModel{}
+	for _, peer := range d {
+		sessions = append(sessions, QuaggaBFDSessionModel{
+			PeerIP:           types.StringValue(peer.Peer),
+			LocalIP:          types.StringValue(peer.Local),
+			Interface:        types.StringValue(peer.Interface),
+			MultiHop:         types.BoolValue(peer.MultiHop),
+			NeighborID:       tools.StringOrNull(neighborIDs[peer.Peer]),
+			Status:           types.StringValue(peer.Status),
+			Uptime:           types.Int64Value(peer.Uptime),
+			Diagnostic:       types.StringValue(peer.Diagnostic),
+			RemoteDiagnostic: types.StringValue(peer.RemoteDiagnostic),
+			DetectMultiplier: types.Int64Value(peer.DetectMultiplier),
+			ReceiveInterval:  types.Int64Value(peer.ReceiveInterval),
+			TransmitInterval: types.Int64Value(peer.TransmitInterval),
+		})
+	}
+
+	v, diags := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: quaggaBFDSessionAttrTypes,
+		},
+		sessions,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert bfd status: %v", diags)
+	}
+
+	return &QuaggaBFDStatusDataSourceModel{
+		Sessions: v,
+	}, nil
+}
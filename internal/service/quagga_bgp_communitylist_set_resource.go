@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuaggaBGPCommunityListSetResource{}
+var _ resource.ResourceWithImportState = &QuaggaBGPCommunityListSetResource{}
+var _ resource.ResourceWithModifyPlan = &QuaggaBGPCommunityListSetResource{}
+
+func NewQuaggaBGPCommunityListSetResource() resource.Resource {
+	return &QuaggaBGPCommunityListSetResource{}
+}
+
+// QuaggaBGPCommunityListSetResource defines the resource implementation.
+type QuaggaBGPCommunityListSetResource struct {
+	client opnsense.Client
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bgp_communitylist_set"
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = quaggaBGPCommunityListSetResourceSchema()
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ownedEntryIDs returns the UUIDs of the community list rows created by this resource, as recorded in its entries.
+func (r *QuaggaBGPCommunityListSetResource) ownedEntryIDs(ctx context.Context, d *QuaggaBGPCommunityListSetResourceModel) []string {
+	if d.Entries.IsNull() || d.Entries.IsUnknown() {
+		return nil
+	}
+
+	var entries []QuaggaBGPCommunityListSetEntryModel
+	d.Entries.ElementsAs(ctx, &entries, false)
+
+	var ids []string
+	for _, entry := range entries {
+		if !entry.Id.IsNull() && !entry.Id.IsUnknown() {
+			ids = append(ids, entry.Id.ValueString())
+		}
+	}
+	return ids
+}
+
+// setEntryIDs records the UUID of the row backing each entry, keyed by sequence number.
+func (r *QuaggaBGPCommunityListSetResource) setEntryIDs(ctx context.Context, d *QuaggaBGPCommunityListSetResourceModel, ids map[string]string) {
+	var entries []QuaggaBGPCommunityListSetEntryModel
+	d.Entries.ElementsAs(ctx, &entries, false)
+
+	for i, entry := range entries {
+		entries[i].Id = types.StringValue(ids[tools.Int64ToString(entry.SeqNumber.ValueInt64())])
+	}
+
+	d.Entries, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: quaggaBGPCommunityListSetEntryTypes}, entries)
+}
+
+// reconcileEntries adds, updates and deletes the rows owned by this community list set, so that exactly one owned row
+// exists for each wanted sequence number. Rows which already match are left untouched, and rows of the same prefix
+// list which are not owned are never changed. It returns the UUID of the row backing each sequence number.
+func (r *QuaggaBGPCommunityListSetResource) reconcileEntries(ctx context.Context, owned []string, wanted []*quagga.BGPCommunityList) (map[string]string, error) {
+	existing, err := r.client.Quagga().GetBGPCommunityListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read bgp community list: %w", err)
+	}
+
+	// Index the owned rows which still exist by sequence number, removing duplicates
+	ownedIDs := map[string]bool{}
+	existingBySequence := map[string]string{}
+	for _, id := range owned {
+		ownedIDs[id] = true
+		communityList, ok := existing[id]
+		if !ok {
+			continue
+		}
+		if _, ok := existingBySequence[communityList.SequenceNumber]; ok {
+			if err := r.client.Quagga().DeleteBGPCommunityList(ctx, id); err != nil {
+				return nil, fmt.Errorf("unable to delete bgp community list: %w", err)
+			}
+			continue
+		}
+		existingBySequence[communityList.SequenceNumber] = id
+	}
+
+	// Reject sequence numbers already used by rows this resource does not own
+	for _, communityList := range wanted {
+		for id, other := range existing {
+			if ownedIDs[id] || other.Number != communityList.Number {
+				continue
+			}
+			if other.SequenceNumber == communityList.SequenceNumber {
+				return nil, fmt.Errorf("sequence number %s of community list %s is already used by row %s, which is not managed by this resource", communityList.SequenceNumber, communityList.Number, id)
+			}
+		}
+	}
+
+	ids := map[string]string{}
+	for _, communityList := range wanted {
+		id, ok := existingBySequence[communityList.SequenceNumber]
+		if !ok {
+			id, err = r.client.Quagga().AddBGPCommunityList(ctx, communityList)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create bgp community list: %w", err)
+			}
+			ids[communityList.SequenceNumber] = id
+			continue
+		}
+
+		delete(existingBySequence, communityList.SequenceNumber)
+		ids[communityList.SequenceNumber] = id
+		if *existing[id] == *communityList {
+			continue
+		}
+		if err := r.client.Quagga().UpdateBGPCommunityList(ctx, id, communityList); err != nil {
+			return nil, fmt.Errorf("unable to update bgp community list: %w", err)
+		}
+	}
+
+	// Delete owned rows no longer wanted
+	for _, id := range existingBySequence {
+		if err := r.client.Quagga().DeleteBGPCommunityList(ctx, id); err != nil {
+			return nil, fmt.Errorf("unable to delete bgp community list: %w", err)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *QuaggaBGPCommunityListSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	communityLists, err := convertQuaggaBGPCommunityListSetSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bgp community list set, got error: %s", err))
+		return
+	}
+
+	// Add bgp community list entries to quagga
+	ids, err := r.reconcileEntries(ctx, nil, communityLists)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create bgp community list set, got error: %s", err))
+		return
+	}
+	r.setEntryIDs(ctx, data, ids)
+
+	// The number identifies the community list
+	data.Id = types.StringValue(tools.Int64ToString(data.Number.ValueInt64()))
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *QuaggaBGPCommunityListSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get bgp community list entries from OPNsense quagga API
+	existing, err := r.client.Quagga().GetBGPCommunityListAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp community list set, got error: %s", err))
+		return
+	}
+
+	communityLists := map[string]*quagga.BGPCommunityList{}
+	owned := r.ownedEntryIDs(ctx, data)
+	if len(owned) == 0 {
+		// An imported community list, or one created before rows were tracked, adopts all rows with its number
+		for id, communityList := range existing {
+			if communityList.Number == data.Id.ValueString() {
+				communityLists[id] = communityList
+			}
+		}
+	} else {
+		for _, id := range owned {
+			if communityList, ok := existing[id]; ok {
+				communityLists[id] = communityList
+			}
+		}
+	}
+
+	if len(communityLists) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("bgp community list set not present in remote, removing from state"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	communityListSetModel, err := convertQuaggaBGPCommunityListSetStructToSchema(data.Id.ValueString(), communityLists)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp community list set, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	communityListSetModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &communityListSetModel)...)
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *QuaggaBGPCommunityListSetResourceModel
+	var state *QuaggaBGPCommunityListSetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	communityLists, err := convertQuaggaBGPCommunityListSetSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bgp community list set, got error: %s", err))
+		return
+	}
+
+	// Update bgp community list entries in quagga
+	ids, err := r.reconcileEntries(ctx, r.ownedEntryIDs(ctx, state), communityLists)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update bgp community list set, got error: %s", err))
+		return
+	}
+	r.setEntryIDs(ctx, data, ids)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBGPCommunityListSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *QuaggaBGPCommunityListSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.reconcileEntries(ctx, r.ownedEntryIDs(ctx, data), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete bgp community list set, got error: %s", err))
+		return
+	}
+}
+
+func (r *QuaggaBGPCommunityListSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config *QuaggaBGPCommunityListSetResourceModel
+
+	// Read Terraform plan and config data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() || plan.Entries.IsUnknown() || config.Entries.IsUnknown() {
+		return
+	}
+
+	var entries, configEntries []QuaggaBGPCommunityListSetEntryModel
+	plan.Entries.ElementsAs(ctx, &entries, false)
+	config.Entries.ElementsAs(ctx, &configEntries, false)
+
+	// Index the sequence numbers and rows of the prior entries
+	prior := map[string][]int64{}
+	priorIDs := map[int64]string{}
+	if !req.State.Raw.IsNull() {
+		var state *QuaggaBGPCommunityListSetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !state.Entries.IsNull() {
+			var stateEntries []QuaggaBGPCommunityListSetEntryModel
+			state.Entries.ElementsAs(ctx, &stateEntries, false)
+			for _, entry := range stateEntries {
+				key := quaggaBGPCommunityListSetEntryKey(entry)
+				prior[key] = append(prior[key], entry.SeqNumber.ValueInt64())
+				priorIDs[entry.SeqNumber.ValueInt64()] = entry.Id.ValueString()
+			}
+		}
+	}
+
+	wanted := make([]quaggaBGPListSetEntry, len(entries))
+	for i, entry := range entries {
+		// Sequence numbers which are not known yet can't be planned around
+		if configEntries[i].SeqNumber.IsUnknown() {
+			return
+		}
+		wanted[i] = quaggaBGPListSetEntry{
+			Key:      quaggaBGPCommunityListSetEntryKey(entry),
+			Sequence: configEntries[i].SeqNumber.ValueInt64(),
+		}
+	}
+
+	sequences, err := planQuaggaBGPListSetSequences(wanted, prior, quaggaBGPCommunityListSetMinSequence, quaggaBGPCommunityListSetMaxSequence)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entries"),
+			"Invalid Sequence Numbers",
+			fmt.Sprintf("Unable to number the entries of community list %d: %s.", plan.Number.ValueInt64(), err),
+		)
+		return
+	}
+
+	for i := range entries {
+		entries[i].SeqNumber = types.Int64Value(sequences[i])
+		if id, ok := priorIDs[sequences[i]]; ok {
+			entries[i].Id = types.StringValue(id)
+		} else {
+			entries[i].Id = types.StringUnknown()
+		}
+	}
+
+	planned, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: quaggaBGPCommunityListSetEntryTypes}, entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entries"), planned)...)
+}
+
+func (r *QuaggaBGPCommunityListSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// OPNsense only accepts community list sequence numbers between 10 and 99.
+const (
+	quaggaBGPCommunityListSetMinSequence = 10
+	quaggaBGPCommunityListSetMaxSequence = 99
+	quaggaBGPCommunityListSetMaxEntries  = 90
+)
+
+// QuaggaBGPCommunityListSetResourceModel describes the resource data model.
+type QuaggaBGPCommunityListSetResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Number      types.Int64  `tfsdk:"number"`
+	Entries     types.List   `tfsdk:"entries"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+type QuaggaBGPCommunityListSetEntryModel struct {
+	SeqNumber types.Int64  `tfsdk:"seq_number"`
+	Action    types.String `tfsdk:"action"`
+	Community types.String `tfsdk:"community"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var quaggaBGPCommunityListSetEntryTypes = map[string]attr.Type{
+	"seq_number": types.Int64Type,
+	"action":     types.StringType,
+	"community":  types.StringType,
+	"id":         types.StringType,
+}
+
+func quaggaBGPCommunityListSetResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure a complete community list for BGP. Each entry is stored as a separate community list row in OPNsense. Only the rows created by this resource are managed: other rows with the same number, such as those of `opnsense_quagga_bgp_communitylist`, are left alone, but may not use the sequence number of an entry.\n\nEntries without a `seq_number` keep the number they were given before. New entries are numbered in steps of 10 between their neighbours where possible, so inserting an entry does not renumber the others.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this community list. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this community list, set on every entry. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "Set the number of your Community-List. 1-99 are standard lists while 100-500 are expanded lists.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 500),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The entries of this community list, in the order they are evaluated. At most 90 entries are supported.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"seq_number": schema.Int64Attribute{
+							MarkdownDescription: "The ACL sequence number (10-99). Must increase along `entries`. Defaults to the number this entry had before, or a new number between those of its neighbours.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(quaggaBGPCommunityListSetMinSequence, quaggaBGPCommunityListSetMaxSequence),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Set permit for match or deny to negate the rule. Defaults to `\"permit\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("permit"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("permit", "deny"),
+							},
+						},
+						"community": schema.StringAttribute{
+							MarkdownDescription: "The community you want to match. You can also regex and it is not validated so please be careful.",
+							Required:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the community list row backing this entry.",
+							Computed:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, quaggaBGPCommunityListSetMaxEntries),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the community list set, which is its number.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// quaggaBGPCommunityListSetEntryKey identifies the content of an entry, or returns "" if it is not yet known.
+func quaggaBGPCommunityListSetEntryKey(entry QuaggaBGPCommunityListSetEntryModel) string {
+	if entry.Action.IsUnknown() || entry.Community.IsUnknown() {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s", entry.Action.ValueString(), entry.Community.ValueString())
+}
+
+func convertQuaggaBGPCommunityListSetSchemaToStruct(d *QuaggaBGPCommunityListSetResourceModel) ([]*quagga.BGPCommunityList, error) {
+	var entries []QuaggaBGPCommunityListSetEntryModel
+	d.Entries.ElementsAs(context.Background(), &entries, false)
+
+	var communityLists []*quagga.BGPCommunityList
+	for _, entry := range entries {
+		if entry.SeqNumber.IsUnknown() || entry.SeqNumber.IsNull() {
+			return nil, fmt.Errorf("sequence number of community %s is not known", entry.Community.ValueString())
+		}
+
+		communityLists = append(communityLists, &quagga.BGPCommunityList{
+			Enabled:        tools.BoolToString(d.Enabled.ValueBool()),
+			Description:    d.Description.ValueString(),
+			Number:         tools.Int64ToString(d.Number.ValueInt64()),
+			SequenceNumber: tools.Int64ToString(entry.SeqNumber.ValueInt64()),
+			Action:         api.SelectedMap(entry.Action.ValueString()),
+			Community:      entry.Community.ValueString(),
+		})
+	}
+
+	return communityLists, nil
+}
+
+func convertQuaggaBGPCommunityListSetStructToSchema(number string, d map[string]*quagga.BGPCommunityList) (*QuaggaBGPCommunityListSetResourceModel, error) {
+	model := &QuaggaBGPCommunityListSetResourceModel{
+		Enabled:     types.BoolValue(true),
+		Description: types.StringValue(""),
+		Number:      types.Int64Value(tools.StringToInt64(number)),
+	}
+
+	// Entries are ordered by their sequence number
+	var ids []string
+	for id := range d {
+		ids = append(ids, id)
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return tools.StringToInt64(d[ids[i]].SequenceNumber) < tools.StringToInt64(d[ids[j]].SequenceNumber)
+	})
+
+	// The settings shared by all entries are taken from the first entry
+	if len(ids) > 0 {
+		model.Enabled = types.BoolValue(tools.StringToBool(d[ids[0]].Enabled))
+		model.Description = types.StringValue(d[ids[0]].Description)
+	}
+
+	entries := []QuaggaBGPCommunityListSetEntryModel{}
+	for _, id := range ids {
+		communityList := d[id]
+		entries = append(entries, QuaggaBGPCommunityListSetEntryModel{
+			SeqNumber: types.Int64Value(tools.StringToInt64(communityList.SequenceNumber)),
+			Action:    types.StringValue(communityList.Action.String()),
+			Community: types.StringValue(communityList.Community),
+			Id:        types.StringValue(id),
+		})
+	}
+
+	model.Entries, _ = types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: quaggaBGPCommunityListSetEntryTypes,
+		},
+		entries,
+	)
+
+	return model, nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"fmt"
+)
+
+// quaggaBGPListSetStep is the gap left between the sequence numbers the provider assigns to the entries of a
+// prefix or community list set, so that entries can be inserted later without renumbering their neighbours.
+const quaggaBGPListSetStep = 10
+
+// quaggaBGPListSetEntry is an entry of a prefix or community list set while its sequence number is planned.
+type quaggaBGPListSetEntry struct {
+	// Key identifies the content of the entry, used to find the sequence number it had in the prior state.
+	// Entries with an empty key are never matched.
+	Key string
+	// Sequence is the configured sequence number, or 0 if the provider should pick one.
+	Sequence int64
+}
+
+// planQuaggaBGPListSetSequences returns a sequence number for each entry, in list order. Configured numbers are
+// kept as is. Entries without one reuse the number their content had in the prior state, so unchanged entries
+// keep their rows, and new entries get a number between those of their neighbours. Numbers must be strictly
+// increasing in list order and lie between minimum and maximum.
+func planQuaggaBGPListSetSequences(entries []quaggaBGPListSetEntry, prior map[string][]int64, minimum int64, maximum int64) ([]int64, error) {
+	sequences := make([]int64, len(entries))
+	configured := make([]bool, len(entries))
+	used := map[int64]bool{}
+	for i, entry := range entries {
+		if entry.Sequence != 0 {
+			sequences[i] = entry.Sequence
+			configured[i] = true
+			used[entry.Sequence] = true
+		}
+	}
+
+	// Reuse the prior sequence numbers of unchanged entries
+	remaining := map[string][]int64{}
+	for key, values := range prior {
+		remaining[key] = append([]int64{}, values...)
+	}
+	for i, entry := range entries {
+		if configured[i] || entry.Key == "" {
+			continue
+		}
+		for len(remaining[entry.Key]) > 0 {
+			value := remaining[entry.Key][0]
+			remaining[entry.Key] = remaining[entry.Key][1:]
+			if !used[value] {
+				sequences[i] = value
+				used[value] = true
+				break
+			}
+		}
+	}
+
+	// Configured numbers must follow the list order
+	previous := int64(0)
+	for i := range entries {
+		if !configured[i] {
+			continue
+		}
+		if sequences[i] <= previous {
+			return nil, fmt.Errorf("entry %d has sequence number %d, which must be greater than %d, the sequence number of an entry before it", i+1, sequences[i], previous)
+		}
+		previous = sequences[i]
+	}
+
+	// Drop reused numbers which no longer fit between their neighbours, so those entries are numbered again
+	previous = 0
+	for i := range entries {
+		if sequences[i] == 0 {
+			continue
+		}
+		if configured[i] {
+			previous = sequences[i]
+			continue
+		}
+
+		next := maximum + 1
+		for j := i + 1; j < len(entries); j++ {
+			if configured[j] {
+				next = sequences[j]
+				break
+			}
+		}
+		if sequences[i] <= previous || sequences[i] >= next {
+			sequences[i] = 0
+			continue
+		}
+		previous = sequences[i]
+	}
+
+	// Number the remaining entries, one run of consecutive entries at a time
+	previous = minimum - 1
+	for i := 0; i < len(entries); {
+		if sequences[i] != 0 {
+			previous = sequences[i]
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(entries) && sequences[end] == 0 {
+			end++
+		}
+		upper := maximum + 1
+		if end < len(entries) {
+			upper = sequences[end]
+		}
+		count := int64(end - i)
+
+		// Prefer multiples of the step, falling back to spreading the entries evenly over the free range
+		first := (previous/quaggaBGPListSetStep + 1) * quaggaBGPListSetStep
+		if first < minimum {
+			first = minimum
+		}
+		if first+(count-1)*quaggaBGPListSetStep < upper {
+			for j := int64(0); j < count; j++ {
+				sequences[i+int(j)] = first + j*quaggaBGPListSetStep
+			}
+		} else {
+			gap := (upper - previous) / (count + 1)
+			if gap == 0 {
+				return nil, fmt.Errorf("no free sequence number between %d and %d for entry %d, set seq_number explicitly", previous, upper, i+1)
+			}
+			for j := int64(0); j < count; j++ {
+				sequences[i+int(j)] = previous + (j+1)*gap
+			}
+		}
+
+		previous = sequences[end-1]
+		i = end
+	}
+
+	return sequences, nil
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &QuaggaBGPNeighborResource{}
 var _ resource.ResourceWithImportState = &QuaggaBGPNeighborResource{}
+var _ resource.ResourceWithModifyPlan = &QuaggaBGPNeighborResource{}
+var _ resource.ResourceWithUpgradeState = &QuaggaBGPNeighborResource{}
 
 func NewQuaggaBGPNeighborResource() resource.Resource {
 	return &QuaggaBGPNeighborResource{}
@@ -40,23 +42,24 @@ func (r *QuaggaBGPNeighborResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *QuaggaBGPNeighborResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *QuaggaBGPNeighborResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, the write-only password is only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("md5_password"), &data.Password)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -131,8 +134,9 @@ func (r *QuaggaBGPNeighborResource) Read(ctx context.Context, req resource.ReadR
 func (r *QuaggaBGPNeighborResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *QuaggaBGPNeighborResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, the write-only password is only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("md5_password"), &data.Password)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -177,6 +181,47 @@ func (r *QuaggaBGPNeighborResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *QuaggaBGPNeighborResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The password is write-only, plan its hash so that changing it updates the resource
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("md5_password"), &secret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("md5_password_hash"), tools.SecretHashValue(secret))...)
+}
+
 func (r *QuaggaBGPNeighborResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *QuaggaBGPNeighborResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := quaggaBGPNeighborResourceSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored md5_password in plain text
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior *QuaggaBGPNeighborResourceModel
+
+				// Read prior state data into the model
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Save upgraded data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeQuaggaBGPNeighborResourceModelV0(prior))...)
+			},
+		},
+	}
+}
@@ -2,9 +2,11 @@ package service
 
 import (
 	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/quagga"
+	lib "github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
 	"terraform-provider-opnsense/internal/tools"
 )
 
@@ -23,6 +26,7 @@ type QuaggaBGPNeighborResourceModel struct {
 	PeerIP                types.String `tfsdk:"peer_ip"`
 	RemoteAS              types.Int64  `tfsdk:"remote_as"`
 	Password              types.String `tfsdk:"md5_password"`
+	PasswordHash          types.String `tfsdk:"md5_password_hash"`
 	Weight                types.Int64  `tfsdk:"weight"`
 	LocalIP               types.String `tfsdk:"local_ip"`
 	UpdateSource          types.String `tfsdk:"update_source"`
@@ -44,13 +48,41 @@ type QuaggaBGPNeighborResourceModel struct {
 	PrefixListOut         types.String `tfsdk:"prefix_list_out"`
 	RouteMapIn            types.String `tfsdk:"route_map_in"`
 	RouteMapOut           types.String `tfsdk:"route_map_out"`
+	PeerGroup             types.String `tfsdk:"peer_group"`
+	IPv4Unicast           types.Bool   `tfsdk:"ipv4_unicast"`
+	IPv6Unicast           types.Bool   `tfsdk:"ipv6_unicast"`
+	AllowASIn             types.Int64  `tfsdk:"allowas_in"`
+	SoftReconfigInbound   types.Bool   `tfsdk:"soft_reconfiguration_inbound"`
+	MaximumPrefix         types.Int64  `tfsdk:"maximum_prefix"`
+	LocalAS               types.Int64  `tfsdk:"local_as"`
 
 	Id types.String `tfsdk:"id"`
 }
 
+// quaggaBGPNeighborResourceSchemaV0 returns version 0 of the resource schema, used to upgrade prior state.
+func quaggaBGPNeighborResourceSchemaV0() schema.Schema {
+	s := quaggaBGPNeighborResourceSchema()
+	s.Version = 0
+	s.Attributes["md5_password"] = schema.StringAttribute{
+		Optional:  true,
+		Computed:  true,
+		Sensitive: true,
+	}
+	return s
+}
+
+// upgradeQuaggaBGPNeighborResourceModelV0 converts version 0 state, which stored the password in plain text. The
+// password is write-only now, so only its hash is kept.
+func upgradeQuaggaBGPNeighborResourceModelV0(d *QuaggaBGPNeighborResourceModel) *QuaggaBGPNeighborResourceModel {
+	d.PasswordHash = types.StringValue(tools.HashSecret(d.Password.ValueString()))
+	d.Password = types.StringNull()
+	return d
+}
+
 func quaggaBGPNeighborResourceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Configure neighbors for BGP.",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"enabled": schema.BoolAttribute{
@@ -70,14 +102,22 @@ func quaggaBGPNeighborResourceSchema() schema.Schema {
 				Required:            true,
 			},
 			"remote_as": schema.Int64Attribute{
-				MarkdownDescription: "The neighbor AS.",
-				Required:            true,
+				MarkdownDescription: "The neighbor AS. Required unless `peer_group` is set, in which case the AS of the peer group is used when this is not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
+					int64validator.AtLeastOneOf(path.MatchRoot("peer_group")),
+				},
 			},
 			"md5_password": schema.StringAttribute{
-				MarkdownDescription: "The password for BGP authentication. Defaults to `\"\"`.",
+				MarkdownDescription: "The password for BGP authentication. Write-only, it is sent to OPNsense but never stored in the Terraform state. Requires Terraform 1.11 or later.",
 				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"md5_password_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of `md5_password`, empty when no password is set. Changes of the password, in the configuration or on the firewall, show up as changes of this hash.",
 				Computed:            true,
-				Default:             stringdefault.StaticString(""),
 			},
 			"weight": schema.Int64Attribute{
 				MarkdownDescription: "Specify a default weight value for the neighbor’s routes. Defaults to `-1`.",
@@ -134,7 +174,7 @@ func quaggaBGPNeighborResourceSchema() schema.Schema {
 				Default:             booldefault.StaticBool(false),
 			},
 			"bfd": schema.BoolAttribute{
-				MarkdownDescription: "Enable BFD support for this neighbor. Defaults to `false`.",
+				MarkdownDescription: "Enable BFD support for this neighbor. Requires BFD to be enabled with `opnsense_quagga_bfd`. Defaults to `false`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -208,6 +248,60 @@ func quaggaBGPNeighborResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"peer_group": schema.StringAttribute{
+				MarkdownDescription: "The peer group ID this neighbor is a member of. The neighbor inherits the settings of the peer group. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"ipv4_unicast": schema.BoolAttribute{
+				MarkdownDescription: "Activate the IPv4 unicast address family for this neighbor. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ipv6_unicast": schema.BoolAttribute{
+				MarkdownDescription: "Activate the IPv6 unicast address family for this neighbor. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allowas_in": schema.Int64Attribute{
+				MarkdownDescription: "Accept routes containing the local AS number in their AS path up to this many times (`allowas-in`). Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 10),
+					int64validator.NoneOf(0),
+				},
+			},
+			"soft_reconfiguration_inbound": schema.BoolAttribute{
+				MarkdownDescription: "Store received routes unmodified, so inbound policy changes can be applied without resetting the session. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"maximum_prefix": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of prefixes accepted from this neighbor before the session is shut down. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 4294967295),
+					int64validator.NoneOf(0),
+				},
+			},
+			"local_as": schema.Int64Attribute{
+				MarkdownDescription: "AS number to present to this neighbor instead of the local AS number (`local-as`). Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 4294967295),
+					int64validator.NoneOf(0),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the neighbor.",
@@ -245,7 +339,12 @@ func QuaggaBGPNeighborDataSourceSchema() dschema.Schema {
 				Computed:            true,
 			},
 			"md5_password": dschema.StringAttribute{
-				MarkdownDescription: "The password for BGP authentication.",
+				MarkdownDescription: "Always null, secrets are not exposed by data sources.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"md5_password_hash": dschema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the password for BGP authentication, empty when no password is set.",
 				Computed:            true,
 			},
 			"weight": dschema.Int64Attribute{
@@ -332,38 +431,81 @@ func QuaggaBGPNeighborDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "The route map ID for outbound direction.",
 				Computed:            true,
 			},
+			"peer_group": dschema.StringAttribute{
+				MarkdownDescription: "The peer group ID this neighbor is a member of.",
+				Computed:            true,
+			},
+			"ipv4_unicast": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the IPv4 unicast address family is activated for this neighbor.",
+				Computed:            true,
+			},
+			"ipv6_unicast": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the IPv6 unicast address family is activated for this neighbor.",
+				Computed:            true,
+			},
+			"allowas_in": dschema.Int64Attribute{
+				MarkdownDescription: "How many times the local AS number may appear in the AS path of accepted routes, `-1` if disabled.",
+				Computed:            true,
+			},
+			"soft_reconfiguration_inbound": dschema.BoolAttribute{
+				MarkdownDescription: "Whether received routes are stored unmodified.",
+				Computed:            true,
+			},
+			"maximum_prefix": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of prefixes accepted from this neighbor, `-1` if disabled.",
+				Computed:            true,
+			},
+			"local_as": dschema.Int64Attribute{
+				MarkdownDescription: "AS number presented to this neighbor instead of the local AS number, `-1` if disabled.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
 func convertQuaggaBGPNeighborSchemaToStruct(d *QuaggaBGPNeighborResourceModel) (*quagga.BGPNeighbor, error) {
+	// An unset remote AS is inherited from the peer group
+	remoteAS := ""
+	if !d.RemoteAS.IsNull() {
+		remoteAS = tools.Int64ToString(d.RemoteAS.ValueInt64())
+	}
+
 	return &quagga.BGPNeighbor{
-		Enabled:               tools.BoolToString(d.Enabled.ValueBool()),
-		Description:           d.Description.ValueString(),
-		PeerIP:                d.PeerIP.ValueString(),
-		RemoteAS:              tools.Int64ToString(d.RemoteAS.ValueInt64()),
-		Password:              d.Password.ValueString(),
-		Weight:                tools.Int64ToStringNegative(d.Weight.ValueInt64()),
-		LocalIP:               d.LocalIP.ValueString(),
-		UpdateSource:          api.SelectedMap(d.UpdateSource.ValueString()),
-		LinkLocalInterface:    api.SelectedMap(d.LinkLocalInterface.ValueString()),
-		NextHopSelf:           tools.BoolToString(d.NextHopSelf.ValueBool()),
-		NextHopSelfAll:        tools.BoolToString(d.NextHopSelfAll.ValueBool()),
-		MultiHop:              tools.BoolToString(d.MultiHop.ValueBool()),
-		MultiProtocol:         tools.BoolToString(d.MultiProtocol.ValueBool()),
-		RRClient:              tools.BoolToString(d.RRClient.ValueBool()),
-		BFD:                   tools.BoolToString(d.BFD.ValueBool()),
-		KeepAlive:             tools.Int64ToString(d.KeepAlive.ValueInt64()),
-		HoldDown:              tools.Int64ToString(d.HoldDown.ValueInt64()),
-		ConnectTimer:          tools.Int64ToStringNegative(d.ConnectTimer.ValueInt64()),
-		DefaultRoute:          tools.BoolToString(d.DefaultRoute.ValueBool()),
-		ASOverride:            tools.BoolToString(d.ASOverride.ValueBool()),
-		DisableConnectedCheck: tools.BoolToString(d.DisableConnectedCheck.ValueBool()),
-		AttributeUnchanged:    api.SelectedMap(d.AttributeUnchanged.ValueString()),
-		PrefixListIn:          api.SelectedMap(d.PrefixListIn.ValueString()),
-		PrefixListOut:         api.SelectedMap(d.PrefixListOut.ValueString()),
-		RouteMapIn:            api.SelectedMap(d.RouteMapIn.ValueString()),
-		RouteMapOut:           api.SelectedMap(d.RouteMapOut.ValueString()),
+		BGPNeighbor: lib.BGPNeighbor{
+			Enabled:               tools.BoolToString(d.Enabled.ValueBool()),
+			Description:           d.Description.ValueString(),
+			PeerIP:                d.PeerIP.ValueString(),
+			RemoteAS:              remoteAS,
+			Password:              d.Password.ValueString(),
+			Weight:                tools.Int64ToStringNegative(d.Weight.ValueInt64()),
+			LocalIP:               d.LocalIP.ValueString(),
+			UpdateSource:          api.SelectedMap(d.UpdateSource.ValueString()),
+			LinkLocalInterface:    api.SelectedMap(d.LinkLocalInterface.ValueString()),
+			NextHopSelf:           tools.BoolToString(d.NextHopSelf.ValueBool()),
+			NextHopSelfAll:        tools.BoolToString(d.NextHopSelfAll.ValueBool()),
+			MultiHop:              tools.BoolToString(d.MultiHop.ValueBool()),
+			MultiProtocol:         tools.BoolToString(d.MultiProtocol.ValueBool()),
+			RRClient:              tools.BoolToString(d.RRClient.ValueBool()),
+			BFD:                   tools.BoolToString(d.BFD.ValueBool()),
+			KeepAlive:             tools.Int64ToString(d.KeepAlive.ValueInt64()),
+			HoldDown:              tools.Int64ToString(d.HoldDown.ValueInt64()),
+			ConnectTimer:          tools.Int64ToStringNegative(d.ConnectTimer.ValueInt64()),
+			DefaultRoute:          tools.BoolToString(d.DefaultRoute.ValueBool()),
+			ASOverride:            tools.BoolToString(d.ASOverride.ValueBool()),
+			DisableConnectedCheck: tools.BoolToString(d.DisableConnectedCheck.ValueBool()),
+			AttributeUnchanged:    api.SelectedMap(d.AttributeUnchanged.ValueString()),
+			PrefixListIn:          api.SelectedMap(d.PrefixListIn.ValueString()),
+			PrefixListOut:         api.SelectedMap(d.PrefixListOut.ValueString()),
+			RouteMapIn:            api.SelectedMap(d.RouteMapIn.ValueString()),
+			RouteMapOut:           api.SelectedMap(d.RouteMapOut.ValueString()),
+		},
+		PeerGroup:                  api.SelectedMap(d.PeerGroup.ValueString()),
+		IPv4Unicast:                tools.BoolToString(d.IPv4Unicast.ValueBool()),
+		IPv6Unicast:                tools.BoolToString(d.IPv6Unicast.ValueBool()),
+		AllowASIn:                  tools.Int64ToStringNegative(d.AllowASIn.ValueInt64()),
+		SoftReconfigurationInbound: tools.BoolToString(d.SoftReconfigInbound.ValueBool()),
+		MaximumPrefix:              tools.Int64ToStringNegative(d.MaximumPrefix.ValueInt64()),
+		LocalAS:                    tools.Int64ToStringNegative(d.LocalAS.ValueInt64()),
 	}, nil
 }
 
@@ -372,8 +514,9 @@ func convertQuaggaBGPNeighborStructToSchema(d *quagga.BGPNeighbor) (*QuaggaBGPNe
 		Enabled:               types.BoolValue(tools.StringToBool(d.Enabled)),
 		Description:           types.StringValue(d.Description),
 		PeerIP:                types.StringValue(d.PeerIP),
-		RemoteAS:              types.Int64Value(tools.StringToInt64(d.RemoteAS)),
-		Password:              types.StringValue(d.Password),
+		RemoteAS:              tools.StringToInt64Null(d.RemoteAS),
+		Password:              types.StringNull(),
+		PasswordHash:          types.StringValue(tools.HashSecret(d.Password)),
 		Weight:                types.Int64Value(tools.StringToInt64(d.Weight)),
 		LocalIP:               types.StringValue(d.LocalIP),
 		UpdateSource:          types.StringValue(d.UpdateSource.String()),
@@ -395,5 +538,12 @@ func convertQuaggaBGPNeighborStructToSchema(d *quagga.BGPNeighbor) (*QuaggaBGPNe
 		PrefixListOut:         types.StringValue(d.PrefixListOut.String()),
 		RouteMapIn:            types.StringValue(d.RouteMapIn.String()),
 		RouteMapOut:           types.StringValue(d.RouteMapOut.String()),
+		PeerGroup:             types.StringValue(d.PeerGroup.String()),
+		IPv4Unicast:           types.BoolValue(tools.StringToBool(d.IPv4Unicast)),
+		IPv6Unicast:           types.BoolValue(tools.StringToBool(d.IPv6Unicast)),
+		AllowASIn:             types.Int64Value(tools.StringToInt64(d.AllowASIn)),
+		SoftReconfigInbound:   types.BoolValue(tools.StringToBool(d.SoftReconfigurationInbound)),
+		MaximumPrefix:         types.Int64Value(tools.StringToInt64(d.MaximumPrefix)),
+		LocalAS:               types.Int64Value(tools.StringToInt64(d.LocalAS)),
 	}, nil
 }
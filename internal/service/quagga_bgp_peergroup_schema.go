@@ -0,0 +1,236 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// QuaggaBGPPeerGroupResourceModel describes the resource data model.
+type QuaggaBGPPeerGroupResourceModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Description   types.String `tfsdk:"description"`
+	Name          types.String `tfsdk:"name"`
+	RemoteAS      types.Int64  `tfsdk:"remote_as"`
+	UpdateSource  types.String `tfsdk:"update_source"`
+	NextHopSelf   types.Bool   `tfsdk:"next_hop_self"`
+	KeepAlive     types.Int64  `tfsdk:"keep_alive"`
+	HoldDown      types.Int64  `tfsdk:"hold_down"`
+	ConnectTimer  types.Int64  `tfsdk:"connect_timer"`
+	PrefixListIn  types.String `tfsdk:"prefix_list_in"`
+	PrefixListOut types.String `tfsdk:"prefix_list_out"`
+	RouteMapIn    types.String `tfsdk:"route_map_in"`
+	RouteMapOut   types.String `tfsdk:"route_map_out"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func quaggaBGPPeerGroupResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure peer groups for BGP. Neighbors that are a member of a peer group inherit its settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this peer group. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this peer group. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the peer group.",
+				Required:            true,
+			},
+			"remote_as": schema.Int64Attribute{
+				MarkdownDescription: "The AS of the peer group members. When not set, each member neighbor must set its own remote AS.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
+				},
+			},
+			"update_source": schema.StringAttribute{
+				MarkdownDescription: "Physical name of the IPv4 interface facing the peers. Must be a valid OPNsense interface in lowercase (e.g. `wan`). Please refer to the FRR documentation for more information. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"next_hop_self": schema.BoolAttribute{
+				MarkdownDescription: "Enable the next-hop-self command. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"keep_alive": schema.Int64Attribute{
+				MarkdownDescription: "Keepalive timer in seconds to check if the peers are still up. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"hold_down": schema.Int64Attribute{
+				MarkdownDescription: "The time in seconds when a peer is considered dead. This is usually 3 times the keepalive timer. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"connect_timer": schema.Int64Attribute{
+				MarkdownDescription: "The time in seconds how fast a peer tries to reconnect. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"prefix_list_in": schema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for inbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prefix_list_out": schema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for outbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"route_map_in": schema.StringAttribute{
+				MarkdownDescription: "The route map ID for inbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"route_map_out": schema.StringAttribute{
+				MarkdownDescription: "The route map ID for outbound direction. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaBGPPeerGroupDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure peer groups for BGP. Neighbors that are a member of a peer group inherit its settings.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Enable this peer group.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this peer group.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "The name of the peer group.",
+				Computed:            true,
+			},
+			"remote_as": dschema.Int64Attribute{
+				MarkdownDescription: "The AS of the peer group members.",
+				Computed:            true,
+			},
+			"update_source": dschema.StringAttribute{
+				MarkdownDescription: "Physical name of the IPv4 interface facing the peers.",
+				Computed:            true,
+			},
+			"next_hop_self": dschema.BoolAttribute{
+				MarkdownDescription: "Enable the next-hop-self command.",
+				Computed:            true,
+			},
+			"keep_alive": dschema.Int64Attribute{
+				MarkdownDescription: "Keepalive timer in seconds, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"hold_down": dschema.Int64Attribute{
+				MarkdownDescription: "The time in seconds when a peer is considered dead, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"connect_timer": dschema.Int64Attribute{
+				MarkdownDescription: "The time in seconds how fast a peer tries to reconnect, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"prefix_list_in": dschema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for inbound direction.",
+				Computed:            true,
+			},
+			"prefix_list_out": dschema.StringAttribute{
+				MarkdownDescription: "The prefix list ID for outbound direction.",
+				Computed:            true,
+			},
+			"route_map_in": dschema.StringAttribute{
+				MarkdownDescription: "The route map ID for inbound direction.",
+				Computed:            true,
+			},
+			"route_map_out": dschema.StringAttribute{
+				MarkdownDescription: "The route map ID for outbound direction.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQuaggaBGPPeerGroupSchemaToStruct(d *QuaggaBGPPeerGroupResourceModel) (*quagga.BGPPeerGroup, error) {
+	// An unset remote AS is set on each member neighbor instead
+	remoteAS := ""
+	if !d.RemoteAS.IsNull() {
+		remoteAS = tools.Int64ToString(d.RemoteAS.ValueInt64())
+	}
+
+	return &quagga.BGPPeerGroup{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Description:   d.Description.ValueString(),
+		Name:          d.Name.ValueString(),
+		RemoteAS:      remoteAS,
+		UpdateSource:  api.SelectedMap(d.UpdateSource.ValueString()),
+		NextHopSelf:   tools.BoolToString(d.NextHopSelf.ValueBool()),
+		KeepAlive:     tools.Int64ToStringNegative(d.KeepAlive.ValueInt64()),
+		HoldDown:      tools.Int64ToStringNegative(d.HoldDown.ValueInt64()),
+		ConnectTimer:  tools.Int64ToStringNegative(d.ConnectTimer.ValueInt64()),
+		PrefixListIn:  api.SelectedMap(d.PrefixListIn.ValueString()),
+		PrefixListOut: api.SelectedMap(d.PrefixListOut.ValueString()),
+		RouteMapIn:    api.SelectedMap(d.RouteMapIn.ValueString()),
+		RouteMapOut:   api.SelectedMap(d.RouteMapOut.ValueString()),
+	}, nil
+}
+
+func convertQuaggaBGPPeerGroupStructToSchema(d *quagga.BGPPeerGroup) (*QuaggaBGPPeerGroupResourceModel, error) {
+	return &QuaggaBGPPeerGroupResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:   types.StringValue(d.Description),
+		Name:          types.StringValue(d.Name),
+		RemoteAS:      tools.StringToInt64Null(d.RemoteAS),
+		UpdateSource:  types.StringValue(d.UpdateSource.String()),
+		NextHopSelf:   types.BoolValue(tools.StringToBool(d.NextHopSelf)),
+		KeepAlive:     types.Int64Value(tools.StringToInt64(d.KeepAlive)),
+		HoldDown:      types.Int64Value(tools.StringToInt64(d.HoldDown)),
+		ConnectTimer:  types.Int64Value(tools.StringToInt64(d.ConnectTimer)),
+		PrefixListIn:  types.StringValue(d.PrefixListIn.String()),
+		PrefixListOut: types.StringValue(d.PrefixListOut.String()),
+		RouteMapIn:    types.StringValue(d.RouteMapIn.String()),
+		RouteMapOut:   types.StringValue(d.RouteMapOut.String()),
+	}, nil
+}
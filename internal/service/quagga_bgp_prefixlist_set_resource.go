@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuaggaBGPPrefixListSetResource{}
+var _ resource.ResourceWithImportState = &QuaggaBGPPrefixListSetResource{}
+var _ resource.ResourceWithModifyPlan = &QuaggaBGPPrefixListSetResource{}
+
+func NewQuaggaBGPPrefixListSetResource() resource.Resource {
+	return &QuaggaBGPPrefixListSetResource{}
+}
+
+// QuaggaBGPPrefixListSetResource defines the resource implementation.
+type QuaggaBGPPrefixListSetResource struct {
+	client opnsense.Client
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bgp_prefixlist_set"
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = quaggaBGPPrefixListSetResourceSchema()
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ownedEntryIDs returns the UUIDs of the prefix list rows created by this resource, as recorded in its entries.
+func (r *QuaggaBGPPrefixListSetResource) ownedEntryIDs(ctx context.Context, d *QuaggaBGPPrefixListSetResourceModel) []string {
+	if d.Entries.IsNull() || d.Entries.IsUnknown() {
+		return nil
+	}
+
+	var entries []QuaggaBGPPrefixListSetEntryModel
+	d.Entries.ElementsAs(ctx, &entries, false)
+
+	var ids []string
+	for _, entry := range entries {
+		if !entry.Id.IsNull() && !entry.Id.IsUnknown() {
+			ids = append(ids, entry.Id.ValueString())
+		}
+	}
+	return ids
+}
+
+// setEntryIDs records the UUID of the row backing each entry, keyed by sequence number.
+func (r *QuaggaBGPPrefixListSetResource) setEntryIDs(ctx context.Context, d *QuaggaBGPPrefixListSetResourceModel, ids map[string]string) {
+	var entries []QuaggaBGPPrefixListSetEntryModel
+	d.Entries.ElementsAs(ctx, &entries, false)
+
+	for i, entry := range entries {
+		entries[i].Id = types.StringValue(ids[tools.Int64ToString(entry.SeqNumber.ValueInt64())])
+	}
+
+	d.Entries, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: quaggaBGPPrefixListSetEntryTypes}, entries)
+}
+
+// reconcileEntries adds, updates and deletes the rows owned by this prefix list set, so that exactly one owned row
+// exists for each wanted sequence number. Rows which already match are left untouched, and rows of the same prefix
+// list which are not owned are never changed. It returns the UUID of the row backing each sequence number.
+func (r *QuaggaBGPPrefixListSetResource) reconcileEntries(ctx context.Context, owned []string, wanted []*quagga.BGPPrefixList) (map[string]string, error) {
+	existing, err := r.client.Quagga().GetBGPPrefixListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read bgp prefix list: %w", err)
+	}
+
+	// Index the owned rows which still exist by sequence number, removing duplicates
+	ownedIDs := map[string]bool{}
+	existingBySequence := map[string]string{}
+	for _, id := range owned {
+		ownedIDs[id] = true
+		prefixList, ok := existing[id]
+		if !ok {
+			continue
+		}
+		if _, ok := existingBySequence[prefixList.SequenceNumber]; ok {
+			if err := r.client.Quagga().DeleteBGPPrefixList(ctx, id); err != nil {
+				return nil, fmt.Errorf("unable to delete bgp prefix list: %w", err)
+			}
+			continue
+		}
+		existingBySequence[prefixList.SequenceNumber] = id
+	}
+
+	// Reject sequence numbers already used by rows this resource does not own
+	for _, prefixList := range wanted {
+		for id, other := range existing {
+			if ownedIDs[id] || other.Name != prefixList.Name || other.IPVersion != prefixList.IPVersion {
+				continue
+			}
+			if other.SequenceNumber == prefixList.SequenceNumber {
+				return nil, fmt.Errorf("sequence number %s of prefix list %s is already used by row %s, which is not managed by this resource", prefixList.SequenceNumber, prefixList.Name, id)
+			}
+		}
+	}
+
+	ids := map[string]string{}
+	for _, prefixList := range wanted {
+		id, ok := existingBySequence[prefixList.SequenceNumber]
+		if !ok {
+			id, err = r.client.Quagga().AddBGPPrefixList(ctx, prefixList)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create bgp prefix list: %w", err)
+			}
+			ids[prefixList.SequenceNumber] = id
+			continue
+		}
+
+		delete(existingBySequence, prefixList.SequenceNumber)
+		ids[prefixList.SequenceNumber] = id
+		if *existing[id] == *prefixList {
+			continue
+		}
+		if err := r.client.Quagga().UpdateBGPPrefixList(ctx, id, prefixList); err != nil {
+			return nil, fmt.Errorf("unable to update bgp prefix list: %w", err)
+		}
+	}
+
+	// Delete owned rows no longer wanted
+	for _, id := range existingBySequence {
+		if err := r.client.Quagga().DeleteBGPPrefixList(ctx, id); err != nil {
+			return nil, fmt.Errorf("unable to delete bgp prefix list: %w", err)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *QuaggaBGPPrefixListSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	prefixLists, err := convertQuaggaBGPPrefixListSetSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bgp prefix list set, got error: %s", err))
+		return
+	}
+
+	// Add bgp prefix list entries to quagga
+	ids, err := r.reconcileEntries(ctx, nil, prefixLists)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create bgp prefix list set, got error: %s", err))
+		return
+	}
+	r.setEntryIDs(ctx, data, ids)
+
+	// The name identifies the prefix list
+	data.Id = data.Name
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *QuaggaBGPPrefixListSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get bgp prefix list entries from OPNsense quagga API
+	existing, err := r.client.Quagga().GetBGPPrefixListAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp prefix list set, got error: %s", err))
+		return
+	}
+
+	prefixLists := map[string]*quagga.BGPPrefixList{}
+	owned := r.ownedEntryIDs(ctx, data)
+	if len(owned) == 0 {
+		// An imported prefix list, or one created before rows were tracked, adopts all rows with its name and IP version
+		for id, prefixList := range existing {
+			if prefixList.Name == data.Id.ValueString() && prefixList.IPVersion.String() == data.IPVersion.ValueString() {
+				prefixLists[id] = prefixList
+			}
+		}
+	} else {
+		for _, id := range owned {
+			if prefixList, ok := existing[id]; ok {
+				prefixLists[id] = prefixList
+			}
+		}
+	}
+
+	if len(prefixLists) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("bgp prefix list set not present in remote, removing from state"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	prefixListSetModel, err := convertQuaggaBGPPrefixListSetStructToSchema(data.Id.ValueString(), prefixLists)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp prefix list set, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	prefixListSetModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &prefixListSetModel)...)
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *QuaggaBGPPrefixListSetResourceModel
+	var state *QuaggaBGPPrefixListSetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	prefixLists, err := convertQuaggaBGPPrefixListSetSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse bgp prefix list set, got error: %s", err))
+		return
+	}
+
+	// Update bgp prefix list entries in quagga
+	ids, err := r.reconcileEntries(ctx, r.ownedEntryIDs(ctx, state), prefixLists)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update bgp prefix list set, got error: %s", err))
+		return
+	}
+	r.setEntryIDs(ctx, data, ids)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBGPPrefixListSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *QuaggaBGPPrefixListSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.reconcileEntries(ctx, r.ownedEntryIDs(ctx, data), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete bgp prefix list set, got error: %s", err))
+		return
+	}
+}
+
+func (r *QuaggaBGPPrefixListSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config *QuaggaBGPPrefixListSetResourceModel
+
+	// Read Terraform plan and config data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() || plan.Entries.IsUnknown() || config.Entries.IsUnknown() {
+		return
+	}
+
+	var entries, configEntries []QuaggaBGPPrefixListSetEntryModel
+	plan.Entries.ElementsAs(ctx, &entries, false)
+	config.Entries.ElementsAs(ctx, &configEntries, false)
+
+	// Index the sequence numbers and rows of the prior entries
+	prior := map[string][]int64{}
+	priorIDs := map[int64]string{}
+	if !req.State.Raw.IsNull() {
+		var state *QuaggaBGPPrefixListSetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !state.Entries.IsNull() {
+			var stateEntries []QuaggaBGPPrefixListSetEntryModel
+			state.Entries.ElementsAs(ctx, &stateEntries, false)
+			for _, entry := range stateEntries {
+				key := quaggaBGPPrefixListSetEntryKey(entry)
+				prior[key] = append(prior[key], entry.SeqNumber.ValueInt64())
+				priorIDs[entry.SeqNumber.ValueInt64()] = entry.Id.ValueString()
+			}
+		}
+	}
+
+	wanted := make([]quaggaBGPListSetEntry, len(entries))
+	for i, entry := range entries {
+		// Sequence numbers which are not known yet can't be planned around
+		if configEntries[i].SeqNumber.IsUnknown() {
+			return
+		}
+		wanted[i] = quaggaBGPListSetEntry{
+			Key:      quaggaBGPPrefixListSetEntryKey(entry),
+			Sequence: configEntries[i].SeqNumber.ValueInt64(),
+		}
+	}
+
+	sequences, err := planQuaggaBGPListSetSequences(wanted, prior, quaggaBGPPrefixListSetMinSequence, quaggaBGPPrefixListSetMaxSequence)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entries"),
+			"Invalid Sequence Numbers",
+			fmt.Sprintf("Unable to number the entries of prefix list %s: %s.", plan.Name.ValueString(), err),
+		)
+		return
+	}
+
+	for i := range entries {
+		entries[i].SeqNumber = types.Int64Value(sequences[i])
+		if id, ok := priorIDs[sequences[i]]; ok {
+			entries[i].Id = types.StringValue(id)
+		} else {
+			entries[i].Id = types.StringUnknown()
+		}
+	}
+
+	planned, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: quaggaBGPPrefixListSetEntryTypes}, entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("entries"), planned)...)
+}
+
+func (r *QuaggaBGPPrefixListSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the name, optionally followed by the IP version
+	name, ipVersion, found := strings.Cut(req.ID, ":")
+	if !found {
+		ipVersion = "IPv4"
+	}
+	if ipVersion != "IPv4" && ipVersion != "IPv6" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name or name:IPv6. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_version"), ipVersion)...)
+}
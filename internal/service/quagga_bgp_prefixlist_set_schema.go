@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/quagga"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// The sequence numbers OPNsense accepts for prefix list rows.
+const (
+	quaggaBGPPrefixListSetMinSequence = 1
+	quaggaBGPPrefixListSetMaxSequence = 4294967294
+)
+
+// QuaggaBGPPrefixListSetResourceModel describes the resource data model.
+type QuaggaBGPPrefixListSetResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	IPVersion   types.String `tfsdk:"ip_version"`
+	Entries     types.List   `tfsdk:"entries"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+type QuaggaBGPPrefixListSetEntryModel struct {
+	SeqNumber types.Int64  `tfsdk:"seq_number"`
+	Action    types.String `tfsdk:"action"`
+	Network   types.String `tfsdk:"network"`
+	GE        types.Int64  `tfsdk:"ge"`
+	LE        types.Int64  `tfsdk:"le"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var quaggaBGPPrefixListSetEntryTypes = map[string]attr.Type{
+	"seq_number": types.Int64Type,
+	"action":     types.StringType,
+	"network":    types.StringType,
+	"ge":         types.Int64Type,
+	"le":         types.Int64Type,
+	"id":         types.StringType,
+}
+
+func quaggaBGPPrefixListSetResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure a complete prefix list for BGP. Each entry is stored as a separate prefix list row in OPNsense. Only the rows created by this resource are managed: other rows with the same name and IP version, such as those of `opnsense_quagga_bgp_prefixlist`, are left alone, but may not use the sequence number of an entry.\n\nEntries without a `seq_number` keep the number they were given before. New entries are numbered in steps of 10 between their neighbours, so inserting an entry does not renumber the others.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this prefix list. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this prefix list, set on every entry. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of this prefix list.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_version": schema.StringAttribute{
+				MarkdownDescription: "Set the IP version to use. Defaults to `\"IPv4\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("IPv4"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("IPv4", "IPv6"),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The entries of this prefix list, in the order they are evaluated.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"seq_number": schema.Int64Attribute{
+							MarkdownDescription: "The ACL sequence number (1-4294967294). Must increase along `entries`. Defaults to the number this entry had before, or a new number between those of its neighbours.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(quaggaBGPPrefixListSetMinSequence, quaggaBGPPrefixListSetMaxSequence),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Set permit for match or deny to negate the rule. Defaults to `\"permit\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("permit"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("permit", "deny"),
+							},
+						},
+						"network": schema.StringAttribute{
+							MarkdownDescription: "The network to match, in CIDR notation (e.g. `10.0.0.0/8`).",
+							Required:            true,
+							Validators: []validator.String{
+								validators.CIDR(),
+							},
+						},
+						"ge": schema.Int64Attribute{
+							MarkdownDescription: "Also match more specific networks with a prefix length of at least this value.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 128),
+							},
+						},
+						"le": schema.Int64Attribute{
+							MarkdownDescription: "Also match more specific networks with a prefix length of at most this value.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 128),
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the prefix list row backing this entry.",
+							Computed:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the prefix list set, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// quaggaBGPPrefixListSetEntryKey identifies the content of an entry, or returns "" if it is not yet known.
+func quaggaBGPPrefixListSetEntryKey(entry QuaggaBGPPrefixListSetEntryModel) string {
+	if entry.Action.IsUnknown() || entry.Network.IsUnknown() || entry.GE.IsUnknown() || entry.LE.IsUnknown() {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s|%s|%s", entry.Action.ValueString(), entry.Network.ValueString(), entry.GE.String(), entry.LE.String())
+}
+
+func convertQuaggaBGPPrefixListSetSchemaToStruct(d *QuaggaBGPPrefixListSetResourceModel) ([]*quagga.BGPPrefixList, error) {
+	var entries []QuaggaBGPPrefixListSetEntryModel
+	d.Entries.ElementsAs(context.Background(), &entries, false)
+
+	var prefixLists []*quagga.BGPPrefixList
+	for _, entry := range entries {
+		if entry.SeqNumber.IsUnknown() || entry.SeqNumber.IsNull() {
+			return nil, fmt.Errorf("sequence number of network %s is not known", entry.Network.ValueString())
+		}
+
+		// OPNsense expects ge and le after the network statement
+		network := entry.Network.ValueString()
+		if !entry.GE.IsNull() {
+			network += fmt.Sprintf(" ge %d", entry.GE.ValueInt64())
+		}
+		if !entry.LE.IsNull() {
+			network += fmt.Sprintf(" le %d", entry.LE.ValueInt64())
+		}
+
+		prefixLists = append(prefixLists, &quagga.BGPPrefixList{
+			Enabled:        tools.BoolToString(d.Enabled.ValueBool()),
+			Description:    d.Description.ValueString(),
+			Name:           d.Name.ValueString(),
+			IPVersion:      api.SelectedMap(d.IPVersion.ValueString()),
+			SequenceNumber: tools.Int64ToString(entry.SeqNumber.ValueInt64()),
+			Action:         api.SelectedMap(entry.Action.ValueString()),
+			Network:        network,
+		})
+	}
+
+	return prefixLists, nil
+}
+
+func convertQuaggaBGPPrefixListSetStructToSchema(name string, d map[string]*quagga.BGPPrefixList) (*QuaggaBGPPrefixListSetResourceModel, error) {
+	model := &QuaggaBGPPrefixListSetResourceModel{
+		Enabled:     types.BoolValue(true),
+		Description: types.StringValue(""),
+		Name:        types.StringValue(name),
+		IPVersion:   types.StringValue("IPv4"),
+	}
+
+	// Entries are ordered by their sequence number
+	var ids []string
+	for id := range d {
+		ids = append(ids, id)
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return tools.StringToInt64(d[ids[i]].SequenceNumber) < tools.StringToInt64(d[ids[j]].SequenceNumber)
+	})
+
+	// The settings shared by all entries are taken from the first entry
+	if len(ids) > 0 {
+		model.Enabled = types.BoolValue(tools.StringToBool(d[ids[0]].Enabled))
+		model.Description = types.StringValue(d[ids[0]].Description)
+		model.IPVersion = types.StringValue(d[ids[0]].IPVersion.String())
+	}
+
+	entries := []QuaggaBGPPrefixListSetEntryModel{}
+	for _, id := range ids {
+		prefixList := d[id]
+		entry := QuaggaBGPPrefixListSetEntryModel{
+			SeqNumber: types.Int64Value(tools.StringToInt64(prefixList.SequenceNumber)),
+			Action:    types.StringValue(prefixList.Action.String()),
+			GE:        types.Int64Null(),
+			LE:        types.Int64Null(),
+			Id:        types.StringValue(id),
+		}
+
+		// Split ge and le off the network statement
+		fields := strings.Fields(prefixList.Network)
+		if len(fields) > 0 {
+			entry.Network = types.StringValue(fields[0])
+		} else {
+			entry.Network = types.StringValue("")
+		}
+		for i := 1; i+1 < len(fields); i += 2 {
+			value, err := strconv.ParseInt(fields[i+1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse network %q: %w", prefixList.Network, err)
+			}
+			switch fields[i] {
+			case "ge":
+				entry.GE = types.Int64Value(value)
+			case "le":
+				entry.LE = types.Int64Value(value)
+			default:
+				return nil, fmt.Errorf("unable to parse network %q", prefixList.Network)
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	model.Entries, _ = types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: quaggaBGPPrefixListSetEntryTypes,
+		},
+		entries,
+	)
+
+	return model, nil
+}
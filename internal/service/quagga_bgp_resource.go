@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuaggaBGPResource{}
+var _ resource.ResourceWithImportState = &QuaggaBGPResource{}
+
+func NewQuaggaBGPResource() resource.Resource {
+	return &QuaggaBGPResource{}
+}
+
+// QuaggaBGPResource defines the resource implementation.
+type QuaggaBGPResource struct {
+	client opnsense.Client
+}
+
+func (r *QuaggaBGPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bgp"
+}
+
+func (r *QuaggaBGPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = QuaggaBGPResourceSchema()
+}
+
+func (r *QuaggaBGPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// apply writes the BGP settings and reconciles the redistribution entries with the plan.
+func (r *QuaggaBGPResource) apply(ctx context.Context, data *QuaggaBGPResourceModel) error {
+	// Convert TF schema OPNsense struct
+	bgp, err := convertQuaggaBGPSchemaToStruct(data)
+	if err != nil {
+		return fmt.Errorf("unable to parse bgp settings: %w", err)
+	}
+
+	redistributions, err := convertQuaggaBGPRedistributionSchemaToStruct(data.Redistribution)
+	if err != nil {
+		return fmt.Errorf("unable to parse bgp redistribution: %w", err)
+	}
+
+	// Update bgp settings in OPNsense
+	if err := r.client.Quagga().UpdateBGP(ctx, bgp); err != nil {
+		return err
+	}
+
+	return r.reconcileRedistributions(ctx, redistributions)
+}
+
+// reconcileRedistributions adds, updates and deletes redistribution entries, so that exactly one entry
+// exists for each wanted protocol.
+func (r *QuaggaBGPResource) reconcileRedistributions(ctx context.Context, wanted []*quagga.BGPRedistribution) error {
+	existing, err := r.client.Quagga().GetBGPRedistributionAll(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read bgp redistribution: %w", err)
+	}
+
+	// Index existing entries by protocol, removing duplicates
+	existingByProtocol := map[string]string{}
+	for id, redistribution := range existing {
+		protocol := redistribution.Redistribute.String()
+		if _, ok := existingByProtocol[protocol]; ok {
+			if err := r.client.Quagga().DeleteBGPRedistribution(ctx, id); err != nil {
+				return fmt.Errorf("unable to delete bgp redistribution: %w", err)
+			}
+			continue
+		}
+		existingByProtocol[protocol] = id
+	}
+
+	for _, redistribution := range wanted {
+		protocol := redistribution.Redistribute.String()
+		id, ok := existingByProtocol[protocol]
+		if !ok {
+			if _, err := r.client.Quagga().AddBGPRedistribution(ctx, redistribution); err != nil {
+				return fmt.Errorf("unable to create bgp redistribution: %w", err)
+			}
+			continue
+		}
+
+		delete(existingByProtocol, protocol)
+		current := existing[id]
+		if current.Enabled == redistribution.Enabled && current.RouteMap == redistribution.RouteMap {
+			continue
+		}
+		redistribution.Description = current.Description
+		if err := r.client.Quagga().UpdateBGPRedistribution(ctx, id, redistribution); err != nil {
+			return fmt.Errorf("unable to update bgp redistribution: %w", err)
+		}
+	}
+
+	// Delete entries no longer wanted
+	for _, id := range existingByProtocol {
+		if err := r.client.Quagga().DeleteBGPRedistribution(ctx, id); err != nil {
+			return fmt.Errorf("unable to delete bgp redistribution: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *QuaggaBGPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *QuaggaBGPResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply bgp settings to quagga
+	err := r.apply(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create bgp settings, got error: %s", err))
+		return
+	}
+
+	// Settings are a singleton, so use a fixed ID
+	data.Id = types.StringValue(quaggaBGPId)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBGPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *QuaggaBGPResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get bgp settings from OPNsense quagga API
+	bgp, err := r.client.Quagga().GetBGP(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp settings, got error: %s", err))
+		return
+	}
+
+	redistributions, err := r.client.Quagga().GetBGPRedistributionAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp redistribution, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	bgpModel, err := convertQuaggaBGPStructToSchema(bgp, redistributions)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp settings, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	bgpModel.Id = types.StringValue(quaggaBGPId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bgpModel)...)
+}
+
+func (r *QuaggaBGPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *QuaggaBGPResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply bgp settings to quagga
+	err := r.apply(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update bgp settings, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuaggaBGPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *QuaggaBGPResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings cannot be removed, reset them to their defaults instead
+	err := r.client.Quagga().UpdateBGP(ctx, quaggaBGPDefaults())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to reset bgp settings, got error: %s", err))
+		return
+	}
+
+	err = r.reconcileRedistributions(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to reset bgp settings, got error: %s", err))
+		return
+	}
+}
+
+func (r *QuaggaBGPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &QuaggaBGPRouteMapResource{}
 var _ resource.ResourceWithImportState = &QuaggaBGPRouteMapResource{}
+var _ resource.ResourceWithModifyPlan = &QuaggaBGPRouteMapResource{}
 
 func NewQuaggaBGPRouteMapResource() resource.Resource {
 	return &QuaggaBGPRouteMapResource{}
@@ -40,16 +42,16 @@ func (r *QuaggaBGPRouteMapResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *QuaggaBGPRouteMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -177,6 +179,125 @@ func (r *QuaggaBGPRouteMapResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *QuaggaBGPRouteMapResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *QuaggaBGPRouteMapResourceModel
+	var config *QuaggaBGPRouteMapResourceModel
+
+	// Read Terraform plan and configuration data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the free text set and the typed set clause in sync
+	switch {
+	case !config.SetClause.IsNull():
+		if !tools.IsFullyKnown(ctx, data.SetClause) {
+			data.Set = types.StringUnknown()
+			break
+		}
+		set, err := renderQuaggaBGPRouteMapSet(ctx, data.SetClause)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("set_clause"), "Invalid Set Clause", err.Error())
+			return
+		}
+		data.Set = types.StringValue(set)
+	case !config.Set.IsNull():
+		if data.Set.IsUnknown() {
+			data.SetClause = types.ObjectUnknown(quaggaBGPRouteMapSetTypes)
+			break
+		}
+		data.SetClause = parseQuaggaBGPRouteMapSet(ctx, data.Set.ValueString())
+	default:
+		data.Set = types.StringValue("")
+		data.SetClause = types.ObjectNull(quaggaBGPRouteMapSetTypes)
+	}
+
+	// Check that the matched lists exist, before the provider has been configured there is nothing to check against
+	if r.client != nil {
+		r.validateMatches(ctx, data, &resp.Diagnostics)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// validateMatches adds an error for every AS path, prefix list and community list ID which does not exist in
+// OPNsense. IDs which are not yet known, e.g. of lists created in the same apply, are skipped. Each kind of list
+// is read once, and only if it is referenced.
+func (r *QuaggaBGPRouteMapResource) validateMatches(ctx context.Context, data *QuaggaBGPRouteMapResourceModel, diags *diag.Diagnostics) {
+	matches := []struct {
+		attribute string
+		ids       types.Set
+		noun      string
+		getAll    func() (map[string]bool, error)
+	}{
+		{"aspaths", data.ASPathList, "AS path", func() (map[string]bool, error) {
+			all, err := r.client.Quagga().GetBGPASPathAll(ctx)
+			return quaggaBGPRouteMapExistingIDs(all), err
+		}},
+		{"prefix_lists", data.PrefixList, "prefix list", func() (map[string]bool, error) {
+			all, err := r.client.Quagga().GetBGPPrefixListAll(ctx)
+			return quaggaBGPRouteMapExistingIDs(all), err
+		}},
+		{"community_lists", data.CommunityList, "community list", func() (map[string]bool, error) {
+			all, err := r.client.Quagga().GetBGPCommunityListAll(ctx)
+			return quaggaBGPRouteMapExistingIDs(all), err
+		}},
+	}
+
+	for _, match := range matches {
+		if match.ids.IsUnknown() {
+			continue
+		}
+
+		var ids []types.String
+		match.ids.ElementsAs(ctx, &ids, false)
+
+		var known []string
+		for _, id := range ids {
+			if !id.IsUnknown() {
+				known = append(known, id.ValueString())
+			}
+		}
+		if len(known) == 0 {
+			continue
+		}
+
+		existing, err := match.getAll()
+		if err != nil {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to read %s entries, got error: %s", match.noun, err))
+			continue
+		}
+
+		for _, id := range known {
+			if !existing[id] {
+				diags.AddAttributeError(
+					path.Root(match.attribute),
+					"Unknown Match Reference",
+					fmt.Sprintf("The %s %s does not exist in OPNsense.", match.noun, id),
+				)
+			}
+		}
+	}
+}
+
+// quaggaBGPRouteMapExistingIDs returns the set of UUIDs of a list read from OPNsense.
+func quaggaBGPRouteMapExistingIDs[T any](all map[string]*T) map[string]bool {
+	ids := map[string]bool{}
+	for id := range all {
+		ids[id] = true
+	}
+	return ids
+}
+
 func (r *QuaggaBGPRouteMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
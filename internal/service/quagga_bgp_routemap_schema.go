@@ -5,8 +5,10 @@ import (
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/quagga"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
 )
 
 // QuaggaBGPRouteMapResourceModel describes the resource data model.
@@ -29,6 +33,7 @@ type QuaggaBGPRouteMapResourceModel struct {
 	PrefixList    types.Set    `tfsdk:"prefix_lists"`
 	CommunityList types.Set    `tfsdk:"community_lists"`
 	Set           types.String `tfsdk:"set"`
+	SetClause     types.Object `tfsdk:"set_clause"`
 
 	Id types.String `tfsdk:"id"`
 }
@@ -92,10 +97,94 @@ func quaggaBGPRouteMapResourceSchema() schema.Schema {
 				ElementType:         types.StringType,
 			},
 			"set": schema.StringAttribute{
-				MarkdownDescription: "Free text field for your set, please be careful! You can set e.g. `local-preference 300` or `community 1:1` (http://www.nongnu.org/quagga/docs/docs-multi/Route-Map-Set-Command.html#Route-Map-Set-Command). Defaults to `\"\"`.",
+				MarkdownDescription: "Free text field for your set, please be careful! You can set e.g. `local-preference 300` or `community 1:1` (http://www.nongnu.org/quagga/docs/docs-multi/Route-Map-Set-Command.html#Route-Map-Set-Command). Prefer `set_clause`, which is rendered into this field. Defaults to `\"\"`.",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("set_clause")),
+				},
+			},
+			"set_clause": schema.SingleNestedAttribute{
+				MarkdownDescription: "Typed set clause, rendered into `set`. Only one set clause is supported per route map entry, because OPNsense writes a single `set` statement for it, so exactly one of the attributes must be configured. When `set` is configured instead, this is parsed from it if possible.",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"local_preference": schema.Int64Attribute{
+						MarkdownDescription: "Set the local preference (`set local-preference`).",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 4294967295),
+							// OPNsense writes a single set statement per route map entry. Child attributes
+							// are only validated when set_clause is configured, so this rejects `{}` as well.
+							int64validator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("metric"),
+								path.MatchRelative().AtParent().AtName("weight"),
+								path.MatchRelative().AtParent().AtName("as_path_prepend"),
+								path.MatchRelative().AtParent().AtName("community"),
+								path.MatchRelative().AtParent().AtName("next_hop"),
+								path.MatchRelative().AtParent().AtName("origin"),
+							),
+						},
+					},
+					"metric": schema.Int64Attribute{
+						MarkdownDescription: "Set the multi-exit discriminator (`set metric`).",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 4294967295),
+						},
+					},
+					"weight": schema.Int64Attribute{
+						MarkdownDescription: "Set the weight (`set weight`).",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 4294967295),
+						},
+					},
+					"as_path_prepend": schema.ListAttribute{
+						MarkdownDescription: "AS numbers to prepend to the AS path, in order (`set as-path prepend`).",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueInt64sAre(int64validator.Between(1, 4294967295)),
+						},
+					},
+					"community": schema.SingleNestedAttribute{
+						MarkdownDescription: "Set the communities (`set community`).",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"values": schema.ListAttribute{
+								MarkdownDescription: "Communities to set, e.g. `65000:100` or `no-export`.",
+								Required:            true,
+								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+							"additive": schema.BoolAttribute{
+								MarkdownDescription: "Add the communities to the existing ones instead of replacing them. Defaults to `false`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+						},
+					},
+					"next_hop": schema.StringAttribute{
+						MarkdownDescription: "Set the next hop (`set ip next-hop` or `set ipv6 next-hop global`).",
+						CustomType:          customtypes.IPAddressType{},
+						Optional:            true,
+						Validators: []validator.String{
+							validators.IPAddress(),
+						},
+					},
+					"origin": schema.StringAttribute{
+						MarkdownDescription: "Set the origin (`set origin`). One of `igp`, `egp` or `incomplete`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(quaggaBGPRouteMapSetOrigins...),
+						},
+					},
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -156,6 +245,53 @@ func QuaggaBGPRouteMapDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Free text field for your set, please be careful! You can set e.g. `local-preference 300` or `community 1:1` (http://www.nongnu.org/quagga/docs/docs-multi/Route-Map-Set-Command.html#Route-Map-Set-Command). Defaults to `\"\"`.",
 				Computed:            true,
 			},
+			"set_clause": dschema.SingleNestedAttribute{
+				MarkdownDescription: "Typed set clause parsed from `set`. Null if `set` has no typed equivalent.",
+				Computed:            true,
+				Attributes: map[string]dschema.Attribute{
+					"local_preference": dschema.Int64Attribute{
+						MarkdownDescription: "The local preference set.",
+						Computed:            true,
+					},
+					"metric": dschema.Int64Attribute{
+						MarkdownDescription: "The multi-exit discriminator set.",
+						Computed:            true,
+					},
+					"weight": dschema.Int64Attribute{
+						MarkdownDescription: "The weight set.",
+						Computed:            true,
+					},
+					"as_path_prepend": dschema.ListAttribute{
+						MarkdownDescription: "AS numbers prepended to the AS path.",
+						Computed:            true,
+						ElementType:         types.Int64Type,
+					},
+					"community": dschema.SingleNestedAttribute{
+						MarkdownDescription: "The communities set.",
+						Computed:            true,
+						Attributes: map[string]dschema.Attribute{
+							"values": dschema.ListAttribute{
+								MarkdownDescription: "Communities set.",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"additive": dschema.BoolAttribute{
+								MarkdownDescription: "Whether the communities are added to the existing ones.",
+								Computed:            true,
+							},
+						},
+					},
+					"next_hop": dschema.StringAttribute{
+						MarkdownDescription: "The next hop set.",
+						CustomType:          customtypes.IPAddressType{},
+						Computed:            true,
+					},
+					"origin": dschema.StringAttribute{
+						MarkdownDescription: "The origin set.",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -173,6 +309,16 @@ func convertQuaggaBGPRouteMapSchemaToStruct(d *QuaggaBGPRouteMapResourceModel) (
 	var communityList []string
 	d.CommunityList.ElementsAs(context.Background(), &communityList, false)
 
+	// Render 'SetClause', which takes precedence over the free text set
+	set := d.Set.ValueString()
+	if !d.SetClause.IsNull() && !d.SetClause.IsUnknown() {
+		var err error
+		set, err = renderQuaggaBGPRouteMapSet(context.Background(), d.SetClause)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &quagga.BGPRouteMap{
 		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
 		Description:   d.Description.ValueString(),
@@ -182,7 +328,7 @@ func convertQuaggaBGPRouteMapSchemaToStruct(d *QuaggaBGPRouteMapResourceModel) (
 		ASPathList:    asPathList,
 		PrefixList:    prefixList,
 		CommunityList: communityList,
-		Set:           d.Set.ValueString(),
+		Set:           set,
 	}, nil
 }
 
@@ -205,5 +351,8 @@ func convertQuaggaBGPRouteMapStructToSchema(d *quagga.BGPRouteMap) (*QuaggaBGPRo
 	// Parse 'CommunityList'
 	model.CommunityList = tools.StringSliceToSet(d.CommunityList)
 
+	// Parse 'SetClause'
+	model.SetClause = parseQuaggaBGPRouteMapSet(context.Background(), d.Set)
+
 	return model, nil
 }
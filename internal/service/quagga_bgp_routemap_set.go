@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"net/netip"
+	"strconv"
+	"strings"
+	"terraform-provider-opnsense/internal/customtypes"
+)
+
+// QuaggaBGPRouteMapSetModel describes a typed set clause of a route map.
+type QuaggaBGPRouteMapSetModel struct {
+	LocalPreference types.Int64                `tfsdk:"local_preference"`
+	Metric          types.Int64                `tfsdk:"metric"`
+	Weight          types.Int64                `tfsdk:"weight"`
+	ASPathPrepend   types.List                 `tfsdk:"as_path_prepend"`
+	Community       types.Object               `tfsdk:"community"`
+	NextHop         customtypes.IPAddressValue `tfsdk:"next_hop"`
+	Origin          types.String               `tfsdk:"origin"`
+}
+
+// QuaggaBGPRouteMapSetCommunityModel describes the communities set by a route map.
+type QuaggaBGPRouteMapSetCommunityModel struct {
+	Values   types.List `tfsdk:"values"`
+	Additive types.Bool `tfsdk:"additive"`
+}
+
+var quaggaBGPRouteMapSetCommunityTypes = map[string]attr.Type{
+	"values":   types.ListType{ElemType: types.StringType},
+	"additive": types.BoolType,
+}
+
+var quaggaBGPRouteMapSetTypes = map[string]attr.Type{
+	"local_preference": types.Int64Type,
+	"metric":           types.Int64Type,
+	"weight":           types.Int64Type,
+	"as_path_prepend":  types.ListType{ElemType: types.Int64Type},
+	"community":        types.ObjectType{AttrTypes: quaggaBGPRouteMapSetCommunityTypes},
+	"next_hop":         customtypes.IPAddressType{},
+	"origin":           types.StringType,
+}
+
+// quaggaBGPRouteMapSetOrigins are the values accepted by `set origin`.
+var quaggaBGPRouteMapSetOrigins = []string{"igp", "egp", "incomplete"}
+
+// renderQuaggaBGPRouteMapSet renders a typed set clause into the FRR syntax stored in the route map's `set`
+// field. OPNsense writes this field as a single `set` statement, so only one clause is rendered.
+func renderQuaggaBGPRouteMapSet(ctx context.Context, o types.Object) (string, error) {
+	if o.IsNull() || o.IsUnknown() {
+		return "", nil
+	}
+
+	var set QuaggaBGPRouteMapSetModel
+	if diags := o.As(ctx, &set, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", fmt.Errorf("unable to parse set clause: %v", diags)
+	}
+
+	switch {
+	case !set.LocalPreference.IsNull():
+		return fmt.Sprintf("local-preference %d", set.LocalPreference.ValueInt64()), nil
+	case !set.Metric.IsNull():
+		return fmt.Sprintf("metric %d", set.Metric.ValueInt64()), nil
+	case !set.Weight.IsNull():
+		return fmt.Sprintf("weight %d", set.Weight.ValueInt64()), nil
+	case !set.ASPathPrepend.IsNull():
+		var asns []int64
+		set.ASPathPrepend.ElementsAs(ctx, &asns, false)
+		parts := make([]string, 0, len(asns))
+		for _, asn := range asns {
+			parts = append(parts, strconv.FormatInt(asn, 10))
+		}
+		return "as-path prepend " + strings.Join(parts, " "), nil
+	case !set.Community.IsNull():
+		var community QuaggaBGPRouteMapSetCommunityModel
+		if diags := set.Community.As(ctx, &community, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return "", fmt.Errorf("unable to parse community: %v", diags)
+		}
+		var values []string
+		community.Values.ElementsAs(ctx, &values, false)
+		s := "community " + strings.Join(values, " ")
+		if community.Additive.ValueBool() {
+			s += " additive"
+		}
+		return s, nil
+	case !set.NextHop.IsNull():
+		addr, err := netip.ParseAddr(set.NextHop.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("next hop must be an IP address, got: %s", set.NextHop.ValueString())
+		}
+		if addr.Is6() {
+			return "ipv6 next-hop global " + addr.String(), nil
+		}
+		return "ip next-hop " + addr.String(), nil
+	case !set.Origin.IsNull():
+		return "origin " + set.Origin.ValueString(), nil
+	}
+
+	return "", nil
+}
+
+// parseQuaggaBGPRouteMapSet parses the `set` field of a route map into a typed set clause. A null object is
+// returned for empty strings and for statements which have no typed equivalent.
+func parseQuaggaBGPRouteMapSet(ctx context.Context, s string) types.Object {
+	null := types.ObjectNull(quaggaBGPRouteMapSetTypes)
+
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return null
+	}
+
+	set := QuaggaBGPRouteMapSetModel{
+		LocalPreference: types.Int64Null(),
+		Metric:          types.Int64Null(),
+		Weight:          types.Int64Null(),
+		ASPathPrepend:   types.ListNull(types.Int64Type),
+		Community:       types.ObjectNull(quaggaBGPRouteMapSetCommunityTypes),
+		NextHop:         customtypes.NewIPAddressNull(),
+		Origin:          types.StringNull(),
+	}
+
+	switch {
+	case fields[0] == "local-preference" && len(fields) == 2:
+		i, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return null
+		}
+		set.LocalPreference = types.Int64Value(i)
+	case fields[0] == "metric" && len(fields) == 2:
+		// Relative metrics (e.g. `+10`) have no typed equivalent
+		if strings.HasPrefix(fields[1], "+") || strings.HasPrefix(fields[1], "-") {
+			return null
+		}
+		i, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return null
+		}
+		set.Metric = types.Int64Value(i)
+	case fields[0] == "weight" && len(fields) == 2:
+		i, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return null
+		}
+		set.Weight = types.Int64Value(i)
+	case fields[0] == "as-path" && len(fields) > 2 && fields[1] == "prepend":
+		var asns []int64
+		for _, field := range fields[2:] {
+			i, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return null
+			}
+			asns = append(asns, i)
+		}
+		set.ASPathPrepend, _ = types.ListValueFrom(ctx, types.Int64Type, asns)
+	case fields[0] == "community":
+		values := fields[1:]
+		additive := false
+		if values[len(values)-1] == "additive" {
+			additive = true
+			values = values[:len(values)-1]
+		}
+		if len(values) == 0 {
+			return null
+		}
+		valuesList, _ := types.ListValueFrom(ctx, types.StringType, values)
+		set.Community, _ = types.ObjectValueFrom(ctx, quaggaBGPRouteMapSetCommunityTypes, QuaggaBGPRouteMapSetCommunityModel{
+			Values:   valuesList,
+			Additive: types.BoolValue(additive),
+		})
+	case fields[0] == "ip" && len(fields) == 3 && fields[1] == "next-hop":
+		addr, err := netip.ParseAddr(fields[2])
+		if err != nil || !addr.Is4() {
+			return null
+		}
+		set.NextHop = customtypes.NewIPAddressValue(addr.String())
+	case fields[0] == "ipv6" && len(fields) == 4 && fields[1] == "next-hop" && fields[2] == "global":
+		addr, err := netip.ParseAddr(fields[3])
+		if err != nil || !addr.Is6() {
+			return null
+		}
+		set.NextHop = customtypes.NewIPAddressValue(addr.String())
+	case fields[0] == "origin" && len(fields) == 2:
+		for _, origin := range quaggaBGPRouteMapSetOrigins {
+			if fields[1] == origin {
+				set.Origin = types.StringValue(origin)
+			}
+		}
+		if set.Origin.IsNull() {
+			return null
+		}
+	default:
+		return null
+	}
+
+	o, diags := types.ObjectValueFrom(ctx, quaggaBGPRouteMapSetTypes, set)
+	if diags.HasError() {
+		return null
+	}
+	return o
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// quaggaBGPId is the ID of the BGP singleton resource.
+const quaggaBGPId = "bgp"
+
+// QuaggaBGPResourceModel describes the resource data model.
+type QuaggaBGPResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	ASNumber           types.Int64  `tfsdk:"as_number"`
+	RouterID           types.String `tfsdk:"router_id"`
+	Networks           types.Set    `tfsdk:"networks"`
+	Redistribution     types.Set    `tfsdk:"redistribution"`
+	GracefulRestart    types.Bool   `tfsdk:"graceful_restart"`
+	LogNeighborChanges types.Bool   `tfsdk:"log_neighbor_changes"`
+	NetworkImportCheck types.Bool   `tfsdk:"network_import_check"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+type QuaggaRedistributionModel struct {
+	Protocol types.String `tfsdk:"protocol"`
+	RouteMap types.String `tfsdk:"route_map"`
+}
+
+var quaggaRedistributionTypes = map[string]attr.Type{
+	"protocol":  types.StringType,
+	"route_map": types.StringType,
+}
+
+func QuaggaBGPResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the BGP instance. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable BGP. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"as_number": schema.Int64Attribute{
+				MarkdownDescription: "The local AS number of this router.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
+				},
+			},
+			"router_id": schema.StringAttribute{
+				MarkdownDescription: "Fixed router ID (an IPv4 address). When left empty, the highest IPv4 address of an interface is used. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"networks": schema.SetAttribute{
+				MarkdownDescription: "Networks to advertise via BGP, in CIDR notation (e.g. `10.0.0.0/24`). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"redistribution": schema.SetNestedAttribute{
+				MarkdownDescription: "Routes from other sources to redistribute into BGP. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Source of the redistributed routes. One of `connected`, `kernel`, `ospf`, `rip` or `static`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("connected", "kernel", "ospf", "rip", "static"),
+							},
+						},
+						"route_map": schema.StringAttribute{
+							MarkdownDescription: "ID of a route map to filter the redistributed routes with. Defaults to `\"\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+				Default: setdefault.StaticValue(
+					tools.EmptySetValue(
+						types.ObjectType{
+							AttrTypes: quaggaRedistributionTypes,
+						},
+					),
+				),
+				Validators: []validator.Set{
+					validators.UniqueSetAttribute("protocol"),
+				},
+			},
+			"graceful_restart": schema.BoolAttribute{
+				MarkdownDescription: "Enable BGP graceful restart. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"log_neighbor_changes": schema.BoolAttribute{
+				MarkdownDescription: "Log neighbor up/down changes and the reasons for them. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"network_import_check": schema.BoolAttribute{
+				MarkdownDescription: "Only advertise networks which are present in the routing table. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the BGP settings, always `bgp`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaBGPDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Read the general settings of the BGP instance.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the BGP settings, always `bgp`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether BGP is enabled.",
+				Computed:            true,
+			},
+			"as_number": dschema.Int64Attribute{
+				MarkdownDescription: "The local AS number of this router.",
+				Computed:            true,
+			},
+			"router_id": dschema.StringAttribute{
+				MarkdownDescription: "Fixed router ID (an IPv4 address).",
+				Computed:            true,
+			},
+			"networks": dschema.SetAttribute{
+				MarkdownDescription: "Networks advertised via BGP.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redistribution": dschema.SetNestedAttribute{
+				MarkdownDescription: "Routes from other sources redistributed into BGP.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"protocol": dschema.StringAttribute{
+							MarkdownDescription: "Source of the redistributed routes.",
+							Computed:            true,
+						},
+						"route_map": dschema.StringAttribute{
+							MarkdownDescription: "ID of the route map the redistributed routes are filtered with.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"graceful_restart": dschema.BoolAttribute{
+				MarkdownDescription: "Whether BGP graceful restart is enabled.",
+				Computed:            true,
+			},
+			"log_neighbor_changes": dschema.BoolAttribute{
+				MarkdownDescription: "Whether neighbor up/down changes are logged.",
+				Computed:            true,
+			},
+			"network_import_check": dschema.BoolAttribute{
+				MarkdownDescription: "Whether only networks present in the routing table are advertised.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// quaggaBGPDefaults returns the BGP settings of a fresh OPNsense install, used to reset the singleton.
+func quaggaBGPDefaults() *quagga.BGP {
+	return &quagga.BGP{
+		Enabled:            "0",
+		ASNumber:           "",
+		RouterID:           "",
+		Networks:           api.SelectedMapList{},
+		Graceful:           "0",
+		LogNeighborChanges: "0",
+		NetworkImportCheck: "1",
+	}
+}
+
+func convertQuaggaBGPSchemaToStruct(d *QuaggaBGPResourceModel) (*quagga.BGP, error) {
+	return &quagga.BGP{
+		Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+		ASNumber:           tools.Int64ToString(d.ASNumber.ValueInt64()),
+		RouterID:           d.RouterID.ValueString(),
+		Networks:           tools.SetToStringSlice(d.Networks),
+		Graceful:           tools.BoolToString(d.GracefulRestart.ValueBool()),
+		LogNeighborChanges: tools.BoolToString(d.LogNeighborChanges.ValueBool()),
+		NetworkImportCheck: tools.BoolToString(d.NetworkImportCheck.ValueBool()),
+	}, nil
+}
+
+func convertQuaggaBGPRedistributionSchemaToStruct(d types.Set) ([]*quagga.BGPRedistribution, error) {
+	var redistributionList []QuaggaRedistributionModel
+	d.ElementsAs(context.Background(), &redistributionList, false)
+
+	var redistributions []*quagga.BGPRedistribution
+	for _, redistribution := range redistributionList {
+		redistributions = append(redistributions, &quagga.BGPRedistribution{
+			Enabled:      "1",
+			Redistribute: api.SelectedMap(redistribution.Protocol.ValueString()),
+			RouteMap:     api.SelectedMap(redistribution.RouteMap.ValueString()),
+		})
+	}
+
+	return redistributions, nil
+}
+
+func convertQuaggaBGPStructToSchema(d *quagga.BGP, r map[string]*quagga.BGPRedistribution) (*QuaggaBGPResourceModel, error) {
+	model := &QuaggaBGPResourceModel{
+		Enabled:            types.BoolValue(tools.StringToBool(d.Enabled)),
+		ASNumber:           types.Int64Value(tools.StringToInt64(d.ASNumber)),
+		RouterID:           types.StringValue(d.RouterID),
+		Networks:           tools.StringSliceToSet(d.Networks),
+		GracefulRestart:    types.BoolValue(tools.StringToBool(d.Graceful)),
+		LogNeighborChanges: types.BoolValue(tools.StringToBool(d.LogNeighborChanges)),
+		NetworkImportCheck: types.BoolValue(tools.StringToBool(d.NetworkImportCheck)),
+	}
+
+	// Only enabled redistributions take effect
+	redistributions := []QuaggaRedistributionModel{}
+	for _, redistribution := range r {
+		if !tools.StringToBool(redistribution.Enabled) {
+			continue
+		}
+		redistributions = append(redistributions, QuaggaRedistributionModel{
+			Protocol: types.StringValue(redistribution.Redistribute.String()),
+			RouteMap: types.StringValue(redistribution.RouteMap.String()),
+		})
+	}
+
+	model.Redistribution, _ = types.SetValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: quaggaRedistributionTypes,
+		},
+		redistributions,
+	)
+
+	return model, nil
+}
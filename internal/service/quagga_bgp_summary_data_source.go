@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QuaggaBGPSummaryDataSource{}
+
+func NewQuaggaBGPSummaryDataSource() datasource.DataSource {
+	return &QuaggaBGPSummaryDataSource{}
+}
+
+// QuaggaBGPSummaryDataSource defines the data source implementation.
+type QuaggaBGPSummaryDataSource struct {
+	client opnsense.Client
+}
+
+func (d *QuaggaBGPSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quagga_bgp_summary"
+}
+
+func (d *QuaggaBGPSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = QuaggaBGPSummaryDataSourceSchema()
+}
+
+func (d *QuaggaBGPSummaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QuaggaBGPSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *QuaggaBGPSummaryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get resources from OPNsense API
+	resources, err := d.client.Quagga().GetBGPSummary(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp summary, got error: %s", err))
+		return
+	}
+
+	// Match sessions to configured neighbors by peer IP. This is best effort, the
+	// summary is still useful without the neighbor IDs.
+	neighborIDs := map[string]string{}
+	neighbors, err := d.client.Quagga().GetBGPNeighborAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error",
+			fmt.Sprintf("Unable to read bgp neighbors, neighbor IDs will not be set, got error: %s", err))
+	}
+	for id, neighbor := range neighbors {
+		neighborIDs[neighbor.PeerIP] = id
+	}
+
+	// Convert OPNsense struct to TF schema
+	model, err := convertQuaggaBGPSummaryStructToSchema(resources, neighborIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read bgp summary, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type QuaggaBGPSummaryDataSourceModel struct {
+	Neighbors types.List `tfsdk:"neighbors"`
+}
+
+type QuaggaBGPSummaryNeighborModel struct {
+	PeerIP           types.String `tfsdk:"peer_ip"`
+	AddressFamily    types.String `tfsdk:"address_family"`
+	NeighborID       types.String `tfsdk:"neighbor_id"`
+	RemoteAS         types.Int64  `tfsdk:"remote_as"`
+	State            types.String `tfsdk:"state"`
+	Uptime           types.String `tfsdk:"uptime"`
+	PrefixesReceived types.Int64  `tfsdk:"prefixes_received"`
+	PrefixesSent     types.Int64  `tfsdk:"prefixes_sent"`
+}
+
+var quaggaBGPSummaryNeighborAttrTypes = map[string]attr.Type{
+	"peer_ip":           types.StringType,
+	"address_family":    types.StringType,
+	"neighbor_id":       types.StringType,
+	"remote_as":         types.Int64Type,
+	"state":             types.StringType,
+	"uptime":            types.StringType,
+	"prefixes_received": types.Int64Type,
+	"prefixes_sent":     types.Int64Type,
+}
+
+func QuaggaBGPSummaryDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The BGP summary can be used to get the runtime state of all BGP sessions, e.g. to verify in a `check` block that a neighbor has reached the `Established` state.",
+
+		Attributes: map[string]schema.Attribute{
+			"neighbors": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all BGP sessions, one entry per neighbor and address family.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"peer_ip": schema.StringAttribute{
+							MarkdownDescription: "The IP of the neighbor.",
+							Computed:            true,
+						},
+						"address_family": schema.StringAttribute{
+							MarkdownDescription: "Address family of the session, e.g. `ipv4_unicast` or `ipv6_unicast`.",
+							Computed:            true,
+						},
+						"neighbor_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the matching `opnsense_quagga_bgp_neighbor`. Null when the session does not match a configured neighbor (e.g. dynamic neighbors).",
+							Computed:            true,
+						},
+						"remote_as": schema.Int64Attribute{
+							MarkdownDescription: "The AS of the neighbor.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the session, e.g. `Established`, `Active` or `Idle`.",
+							Computed:            true,
+						},
+						"uptime": schema.StringAttribute{
+							MarkdownDescription: "Time the session has been in its current state, e.g. `01:02:03`.",
+							Computed:            true,
+						},
+						"prefixes_received": schema.Int64Attribute{
+							MarkdownDescription: "Number of prefixes received from the neighbor.",
+							Computed:            true,
+						},
+						"prefixes_sent": schema.Int64Attribute{
+							MarkdownDescription: "Number of prefixes sent to the neighbor.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var camelCaseBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func convertQuaggaBGPSummaryStructToSchema(d quagga.BGPSummary, neighborIDs map[string]string) (*QuaggaBGPSummaryDataSourceModel, error) {
+	// Sort address families, so the list order is stable between reads
+	var families []string
+	for family := range d {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	neighbors := []QuaggaBGPSummaryNeighborModel{}
+	for _, family := range families {
+		var peers []string
+		for peer := range d[family].Peers {
+			peers = append(peers, peer)
+		}
+		sort.Strings(peers)
+
+		for _, peer := range peers {
+			p := d[family].Peers[peer]
+			neighbors = append(neighbors, QuaggaBGPSummaryNeighborModel{
+				PeerIP:           types.StringValue(peer),
+				AddressFamily:    types.StringValue(strings.ToLower(camelCaseBoundary.ReplaceAllString(family, "${1}_${2}"))),
+				NeighborID:       tools.StringOrNull(neighborIDs[peer]),
+				RemoteAS:         types.Int64Value(p.RemoteAS),
+				State:            types.StringValue(p.State),
+				Uptime:           types.StringValue(p.Uptime),
+				PrefixesReceived: types.Int64Value(p.PrefixesReceived),
+				PrefixesSent:     types.Int64Value(p.PrefixesSent),
+			})
+		}
+	}
+
+	v, diags := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: quaggaBGPSummaryNeighborAttrTypes,
+		},
+		neighbors,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert bgp summary: %v", diags)
+	}
+
+	return &QuaggaBGPSummaryDataSourceModel{
+		Neighbors: v,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// QuaggaOSPF6InterfaceResourceModel describes the resource data model.
+type QuaggaOSPF6InterfaceResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Interface          types.String `tfsdk:"interface"`
+	Area               types.String `tfsdk:"area"`
+	Cost               types.Int64  `tfsdk:"cost"`
+	HelloInterval      types.Int64  `tfsdk:"hello_interval"`
+	DeadInterval       types.Int64  `tfsdk:"dead_interval"`
+	RetransmitInterval types.Int64  `tfsdk:"retransmit_interval"`
+	TransmitDelay      types.Int64  `tfsdk:"transmit_delay"`
+	Priority           types.Int64  `tfsdk:"priority"`
+	NetworkType        types.String `tfsdk:"network_type"`
+	Passive            types.Bool   `tfsdk:"passive"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func quaggaOSPF6InterfaceResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure interfaces for OSPFv3.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this interface. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface to run OSPFv3 on. This uses an identifier like `lan` or `opt2`.",
+				Required:            true,
+			},
+			"area": schema.StringAttribute{
+				MarkdownDescription: "The area this interface belongs to, in dotted decimal (e.g. `0.0.0.0` for the backbone area) or integer notation.",
+				Required:            true,
+				Validators:          quaggaOSPFAreaValidators,
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "The OSPF metric of this interface; lower costs are preferred. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaOSPFCostValidators,
+			},
+			"hello_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between hello packets. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaOSPFTimerValidators,
+			},
+			"dead_interval": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds without hello packets after which a neighbor is marked as down. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaOSPFTimerValidators,
+			},
+			"retransmit_interval": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait before resending an unacknowledged LSA. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaOSPFTimerValidators,
+			},
+			"transmit_delay": schema.Int64Attribute{
+				MarkdownDescription: "Estimated time in seconds to transmit an LSA on this interface. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators:          quaggaOSPFTimerValidators,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Router priority in the designated router election; higher values are preferred and `0` never becomes designated router. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"network_type": schema.StringAttribute{
+				MarkdownDescription: "The OSPF network type of this interface. One of `broadcast` or `point-to-point`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "broadcast", "point-to-point"),
+				},
+			},
+			"passive": schema.BoolAttribute{
+				MarkdownDescription: "Do not send hello packets on this interface, while still advertising its networks. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the interface.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaOSPF6InterfaceDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure interfaces for OSPFv3.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this interface is enabled.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface OSPFv3 runs on.",
+				Computed:            true,
+			},
+			"area": dschema.StringAttribute{
+				MarkdownDescription: "The area this interface belongs to.",
+				Computed:            true,
+			},
+			"cost": dschema.Int64Attribute{
+				MarkdownDescription: "The OSPF metric of this interface.",
+				Computed:            true,
+			},
+			"hello_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between hello packets.",
+				Computed:            true,
+			},
+			"dead_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Time in seconds without hello packets after which a neighbor is marked as down.",
+				Computed:            true,
+			},
+			"retransmit_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait before resending an unacknowledged LSA.",
+				Computed:            true,
+			},
+			"transmit_delay": dschema.Int64Attribute{
+				MarkdownDescription: "Estimated time in seconds to transmit an LSA on this interface.",
+				Computed:            true,
+			},
+			"priority": dschema.Int64Attribute{
+				MarkdownDescription: "Router priority in the designated router election.",
+				Computed:            true,
+			},
+			"network_type": dschema.StringAttribute{
+				MarkdownDescription: "The OSPF network type of this interface.",
+				Computed:            true,
+			},
+			"passive": dschema.BoolAttribute{
+				MarkdownDescription: "Whether hello packets are suppressed on this interface.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQuaggaOSPF6InterfaceSchemaToStruct(d *QuaggaOSPF6InterfaceResourceModel) (*quagga.OSPF6Interface, error) {
+	return &quagga.OSPF6Interface{
+		Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+		InterfaceName:      api.SelectedMap(d.Interface.ValueString()),
+		Area:               d.Area.ValueString(),
+		Cost:               tools.Int64ToStringNegative(d.Cost.ValueInt64()),
+		HelloInterval:      tools.Int64ToStringNegative(d.HelloInterval.ValueInt64()),
+		DeadInterval:       tools.Int64ToStringNegative(d.DeadInterval.ValueInt64()),
+		RetransmitInterval: tools.Int64ToStringNegative(d.RetransmitInterval.ValueInt64()),
+		TransmitDelay:      tools.Int64ToStringNegative(d.TransmitDelay.ValueInt64()),
+		Priority:           tools.Int64ToStringNegative(d.Priority.ValueInt64()),
+		NetworkType:        api.SelectedMap(d.NetworkType.ValueString()),
+		Passive:            tools.BoolToString(d.Passive.ValueBool()),
+	}, nil
+}
+
+func convertQuaggaOSPF6InterfaceStructToSchema(d *quagga.OSPF6Interface) (*QuaggaOSPF6InterfaceResourceModel, error) {
+	return &QuaggaOSPF6InterfaceResourceModel{
+		Enabled:            types.BoolValue(tools.StringToBool(d.Enabled)),
+		Interface:          types.StringValue(d.InterfaceName.String()),
+		Area:               types.StringValue(d.Area),
+		Cost:               types.Int64Value(tools.StringToInt64(d.Cost)),
+		HelloInterval:      types.Int64Value(tools.StringToInt64(d.HelloInterval)),
+		DeadInterval:       types.Int64Value(tools.StringToInt64(d.DeadInterval)),
+		RetransmitInterval: types.Int64Value(tools.StringToInt64(d.RetransmitInterval)),
+		TransmitDelay:      types.Int64Value(tools.StringToInt64(d.TransmitDelay)),
+		Priority:           types.Int64Value(tools.StringToInt64(d.Priority)),
+		NetworkType:        types.StringValue(d.NetworkType.String()),
+		Passive:            types.BoolValue(tools.StringToBool(d.Passive)),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// quaggaOSPF6Id is the ID of the OSPFv3 singleton resource.
+const quaggaOSPF6Id = "ospf6"
+
+// QuaggaOSPF6ResourceModel describes the resource data model.
+type QuaggaOSPF6ResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	RouterID     types.String `tfsdk:"router_id"`
+	Redistribute types.Set    `tfsdk:"redistribute"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// quaggaOSPF6RedistributionProtocols are the route sources which can be redistributed into OSPFv3.
+var quaggaOSPF6RedistributionProtocols = []string{"bgp", "connected", "kernel", "ripng", "static"}
+
+func quaggaOSPF6ResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the OSPFv3 instance. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable OSPFv3. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"router_id": schema.StringAttribute{
+				MarkdownDescription: "Fixed router ID (an IPv4 address, also for OSPFv3). When left empty, the highest IPv4 address of an interface is used. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"redistribute": schema.SetAttribute{
+				MarkdownDescription: "Sources of routes to redistribute into OSPFv3. Any of `bgp`, `connected`, `kernel`, `ripng` or `static`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(quaggaOSPF6RedistributionProtocols...),
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the OSPFv3 settings, always `ospf6`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaOSPF6DataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of the OSPFv3 instance.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the OSPFv3 settings, always `ospf6`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether OSPFv3 is enabled.",
+				Computed:            true,
+			},
+			"router_id": dschema.StringAttribute{
+				MarkdownDescription: "Fixed router ID (an IPv4 address).",
+				Computed:            true,
+			},
+			"redistribute": dschema.SetAttribute{
+				MarkdownDescription: "Sources of routes redistributed into OSPFv3.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// quaggaOSPF6Defaults returns the OSPFv3 settings of a fresh OPNsense install, used to reset the singleton.
+func quaggaOSPF6Defaults() *quagga.OSPF6 {
+	return &quagga.OSPF6{
+		Enabled:      "0",
+		RouterID:     "",
+		Redistribute: api.SelectedMapList{},
+	}
+}
+
+func convertQuaggaOSPF6SchemaToStruct(d *QuaggaOSPF6ResourceModel) (*quagga.OSPF6, error) {
+	return &quagga.OSPF6{
+		Enabled:      tools.BoolToString(d.Enabled.ValueBool()),
+		RouterID:     d.RouterID.ValueString(),
+		Redistribute: tools.SetToStringSlice(d.Redistribute),
+	}, nil
+}
+
+func convertQuaggaOSPF6StructToSchema(d *quagga.OSPF6) (*QuaggaOSPF6ResourceModel, error) {
+	return &QuaggaOSPF6ResourceModel{
+		Enabled:      types.BoolValue(tools.StringToBool(d.Enabled)),
+		RouterID:     types.StringValue(d.RouterID),
+		Redistribute: tools.StringSliceToSet(d.Redistribute),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// QuaggaOSPFAreaResourceModel describes the resource data model.
+type QuaggaOSPFAreaResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Area        types.String `tfsdk:"area"`
+	Type        types.String `tfsdk:"type"`
+	NoSummary   types.Bool   `tfsdk:"no_summary"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func quaggaOSPFAreaResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the type of an OSPF area, e.g. to turn it into a stub or not-so-stubby area (NSSA).",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this area. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this area. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"area": schema.StringAttribute{
+				MarkdownDescription: "The area ID, in dotted decimal (e.g. `0.0.0.1`) or integer notation. The backbone area cannot be a stub or NSSA.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.OSPFArea(),
+					stringvalidator.NoneOf("0", "0.0.0.0"),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The area type. One of `stub` or `nssa`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("stub", "nssa"),
+				},
+			},
+			"no_summary": schema.BoolAttribute{
+				MarkdownDescription: "Do not inject inter-area routes into this area, making it a totally stubby area. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the area.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaOSPFAreaDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the type of an OSPF area.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this area is enabled.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this area.",
+				Computed:            true,
+			},
+			"area": dschema.StringAttribute{
+				MarkdownDescription: "The area ID.",
+				Computed:            true,
+			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "The area type, `stub` or `nssa`.",
+				Computed:            true,
+			},
+			"no_summary": dschema.BoolAttribute{
+				MarkdownDescription: "Whether inter-area routes are kept out of this area.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQuaggaOSPFAreaSchemaToStruct(d *QuaggaOSPFAreaResourceModel) (*quagga.OSPFArea, error) {
+	return &quagga.OSPFArea{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Description: d.Description.ValueString(),
+		Area:        d.Area.ValueString(),
+		Type:        api.SelectedMap(d.Type.ValueString()),
+		NoSummary:   tools.BoolToString(d.NoSummary.ValueBool()),
+	}, nil
+}
+
+func convertQuaggaOSPFAreaStructToSchema(d *quagga.OSPFArea) (*QuaggaOSPFAreaResourceModel, error) {
+	return &QuaggaOSPFAreaResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description: types.StringValue(d.Description),
+		Area:        types.StringValue(d.Area),
+		Type:        types.StringValue(d.Type.String()),
+		NoSummary:   types.BoolValue(tools.StringToBool(d.NoSummary)),
+	}, nil
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &QuaggaOSPFInterfaceResource{}
 var _ resource.ResourceWithImportState = &QuaggaOSPFInterfaceResource{}
+var _ resource.ResourceWithModifyPlan = &QuaggaOSPFInterfaceResource{}
+var _ resource.ResourceWithUpgradeState = &QuaggaOSPFInterfaceResource{}
 
 func NewQuaggaOSPFInterfaceResource() resource.Resource {
 	return &QuaggaOSPFInterfaceResource{}
@@ -40,23 +42,24 @@ func (r *QuaggaOSPFInterfaceResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *QuaggaOSPFInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *QuaggaOSPFInterfaceResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, the write-only key is only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authkey"), &data.AuthKey)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -131,8 +134,9 @@ func (r *QuaggaOSPFInterfaceResource) Read(ctx context.Context, req resource.Rea
 func (r *QuaggaOSPFInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *QuaggaOSPFInterfaceResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, the write-only key is only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authkey"), &data.AuthKey)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -177,6 +181,65 @@ func (r *QuaggaOSPFInterfaceResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+func (r *QuaggaOSPFInterfaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The key is write-only, plan its hash so that changing it updates the resource
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authkey"), &secret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("authkey_hash"), tools.SecretHashValue(secret))...)
+}
+
 func (r *QuaggaOSPFInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *QuaggaOSPFInterfaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchemaV0 := quaggaOSPFInterfaceResourceSchemaV0()
+	priorSchemaV1 := quaggaOSPFInterfaceResourceSchemaV1()
+
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored authkey as a number, with -1 for no key
+		0: {
+			PriorSchema: &priorSchemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior *QuaggaOSPFInterfaceResourceModelV0
+
+				// Read prior state data into the model
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Save upgraded data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeQuaggaOSPFInterfaceResourceModelV0(prior))...)
+			},
+		},
+		// Version 1 stored authkey in plain text
+		1: {
+			PriorSchema: &priorSchemaV1,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior *QuaggaOSPFInterfaceResourceModel
+
+				// Read prior state data into the model
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Save upgraded data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeQuaggaOSPFInterfaceResourceModelV1(prior))...)
+			},
+		},
+	}
+}
@@ -15,6 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// Validators shared by the OSPF and OSPFv3 schemas.
+var (
+	quaggaOSPFAreaValidators  = []validator.String{validators.OSPFArea()}
+	quaggaOSPFCostValidators  = []validator.Int64{int64validator.Between(1, 65535)}
+	quaggaOSPFTimerValidators = []validator.Int64{int64validator.Between(0, 4294967295)}
 )
 
 // OSPFInterfaceResourceModel describes the resource data model.
@@ -22,7 +30,8 @@ type QuaggaOSPFInterfaceResourceModel struct {
 	Enabled       types.Bool   `tfsdk:"enabled"`
 	InterfaceName types.String `tfsdk:"interfacename"`
 	AuthType      types.String `tfsdk:"authtype"`
-	AuthKey       types.Int64  `tfsdk:"authkey"`
+	AuthKey       types.String `tfsdk:"authkey"`
+	AuthKeyHash   types.String `tfsdk:"authkey_hash"`
 	AuthKeyID     types.Int64  `tfsdk:"authkey_id"`
 	Area          types.String `tfsdk:"area"`
 	Cost          types.Int64  `tfsdk:"cost"`
@@ -40,9 +49,95 @@ type QuaggaOSPFInterfaceResourceModel struct {
 	Id types.String `tfsdk:"id"`
 }
 
+// QuaggaOSPFInterfaceResourceModelV0 describes version 0 of the resource data model, which stored authkey as a
+// number.
+type QuaggaOSPFInterfaceResourceModelV0 struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	InterfaceName      types.String `tfsdk:"interfacename"`
+	AuthType           types.String `tfsdk:"authtype"`
+	AuthKey            types.Int64  `tfsdk:"authkey"`
+	AuthKeyID          types.Int64  `tfsdk:"authkey_id"`
+	Area               types.String `tfsdk:"area"`
+	Cost               types.Int64  `tfsdk:"cost"`
+	CostDemoted        types.Int64  `tfsdk:"cost_demoted"`
+	HelloInterval      types.Int64  `tfsdk:"hellointerval"`
+	DeadInterval       types.Int64  `tfsdk:"deadinterval"`
+	RetransmitInterval types.Int64  `tfsdk:"retransmitinterval"`
+	RetransmitDelay    types.Int64  `tfsdk:"retransmitdelay"`
+	TransmitDelay      types.Int64  `tfsdk:"transmitdelay"`
+	Priority           types.Int64  `tfsdk:"priority"`
+	BFD                types.Bool   `tfsdk:"bfd"`
+	NetworkType        types.String `tfsdk:"networktype"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// quaggaOSPFInterfaceResourceSchemaV0 returns version 0 of the resource schema, used to upgrade prior state.
+func quaggaOSPFInterfaceResourceSchemaV0() schema.Schema {
+	s := quaggaOSPFInterfaceResourceSchema()
+	s.Version = 0
+	s.Attributes["authkey"] = schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+	}
+	delete(s.Attributes, "authkey_hash")
+	return s
+}
+
+// upgradeQuaggaOSPFInterfaceResourceModelV0 converts version 0 state, where -1 meant no key. The key itself is
+// write-only now, so only its hash is kept.
+func upgradeQuaggaOSPFInterfaceResourceModelV0(d *QuaggaOSPFInterfaceResourceModelV0) *QuaggaOSPFInterfaceResourceModel {
+	authKeyHash := types.StringValue("")
+	if !d.AuthKey.IsNull() && d.AuthKey.ValueInt64() >= 0 {
+		authKeyHash = types.StringValue(tools.HashSecret(tools.Int64ToString(d.AuthKey.ValueInt64())))
+	}
+
+	return &QuaggaOSPFInterfaceResourceModel{
+		Enabled:            d.Enabled,
+		InterfaceName:      d.InterfaceName,
+		AuthType:           d.AuthType,
+		AuthKey:            types.StringNull(),
+		AuthKeyHash:        authKeyHash,
+		AuthKeyID:          d.AuthKeyID,
+		Area:               d.Area,
+		Cost:               d.Cost,
+		CostDemoted:        d.CostDemoted,
+		HelloInterval:      d.HelloInterval,
+		DeadInterval:       d.DeadInterval,
+		RetransmitInterval: d.RetransmitInterval,
+		RetransmitDelay:    d.RetransmitDelay,
+		TransmitDelay:      d.TransmitDelay,
+		Priority:           d.Priority,
+		BFD:                d.BFD,
+		NetworkType:        d.NetworkType,
+		Id:                 d.Id,
+	}
+}
+
+// quaggaOSPFInterfaceResourceSchemaV1 returns version 1 of the resource schema, used to upgrade prior state.
+func quaggaOSPFInterfaceResourceSchemaV1() schema.Schema {
+	s := quaggaOSPFInterfaceResourceSchema()
+	s.Version = 1
+	s.Attributes["authkey"] = schema.StringAttribute{
+		Optional:  true,
+		Computed:  true,
+		Sensitive: true,
+	}
+	return s
+}
+
+// upgradeQuaggaOSPFInterfaceResourceModelV1 converts version 1 state, which stored the key in plain text. The key
+// is write-only now, so only its hash is kept.
+func upgradeQuaggaOSPFInterfaceResourceModelV1(d *QuaggaOSPFInterfaceResourceModel) *QuaggaOSPFInterfaceResourceModel {
+	d.AuthKeyHash = types.StringValue(tools.HashSecret(d.AuthKey.ValueString()))
+	d.AuthKey = types.StringNull()
+	return d
+}
+
 func quaggaOSPFInterfaceResourceSchema() schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Configure interface for OSPF",
+		Version:             2,
 
 		Attributes: map[string]schema.Attribute{
 			"enabled": schema.BoolAttribute{
@@ -66,11 +161,18 @@ func quaggaOSPFInterfaceResourceSchema() schema.Schema {
 					stringvalidator.OneOf("", "message-digest", "plain"),
 				},
 			},
-			"authkey": schema.Int64Attribute{
-				MarkdownDescription: "Specifies a password or key used for plain or MD5 authentication. Defaults to `-1`.",
+			"authkey": schema.StringAttribute{
+				MarkdownDescription: "Specifies a password or key used for plain (up to 8 characters) or MD5 (up to 16 characters) authentication. Write-only, it is sent to OPNsense but never stored in the Terraform state. Requires Terraform 1.11 or later.",
 				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(16),
+				},
+			},
+			"authkey_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of `authkey`, empty when no key is set. Changes of the key, in the configuration or on the firewall, show up as changes of this hash.",
 				Computed:            true,
-				Default:             int64default.StaticInt64(-1),
 			},
 			"authkey_id": schema.Int64Attribute{
 				MarkdownDescription: "Numeric identifier for MD5 authentication, ensuring correct key selection. The auth key ID. Defaults to `1`.",
@@ -84,24 +186,21 @@ func quaggaOSPFInterfaceResourceSchema() schema.Schema {
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
+				Validators:          quaggaOSPFAreaValidators,
 			},
 			"cost": schema.Int64Attribute{
 				MarkdownDescription: "Sets the OSPF metric for path selection; lower costs are preferred paths within the area. Defaults to `-1`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(40),
-				Validators: []validator.Int64{
-					int64validator.Between(1, 65535),
-				},
+				Validators:          quaggaOSPFCostValidators,
 			},
 			"cost_demoted": schema.Int64Attribute{
 				MarkdownDescription: "Specifies metric cost when interface is in backup mode via CARP, deprioritizing paths dynamically. Defaults to `65535`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(65535),
-				Validators: []validator.Int64{
-					int64validator.Between(1, 65535),
-				},
+				Validators:          quaggaOSPFCostValidators,
 			},
 			// "carp_depend_on": schema.StringAttribute{
 			// 	MarkdownDescription: "Links the interface cost to a CARP VHID, adjusting costs based on primary or backup status.",
@@ -114,45 +213,35 @@ func quaggaOSPFInterfaceResourceSchema() schema.Schema {
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
-				Validators: []validator.Int64{
-					int64validator.Between(0, 4294967295),
-				},
+				Validators:          quaggaOSPFTimerValidators,
 			},
 			"deadinterval": schema.Int64Attribute{
 				MarkdownDescription: "Defines the timeout period for OSPF neighbors; after this period, the neighbor is marked as down. Defaults to `-1`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
-				Validators: []validator.Int64{
-					int64validator.Between(0, 4294967295),
-				},
+				Validators:          quaggaOSPFTimerValidators,
 			},
 			"retransmitinterval": schema.Int64Attribute{
 				MarkdownDescription: "Time (seconds) to wait before resending Link-State Advertisements (LSAs) if acknowledgment is delayed. Defaults to `-1`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
-				Validators: []validator.Int64{
-					int64validator.Between(0, 4294967295),
-				},
+				Validators:          quaggaOSPFTimerValidators,
 			},
 			"retransmitdelay": schema.Int64Attribute{
 				MarkdownDescription: "Configures the hold time before LSAs are resent, accommodating slow or high-latency links. Defaults to `-1`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
-				Validators: []validator.Int64{
-					int64validator.Between(0, 4294967295),
-				},
+				Validators:          quaggaOSPFTimerValidators,
 			},
 			"transmitdelay": schema.Int64Attribute{
 				MarkdownDescription: "Configures the hold time before LSAs are resent, accommodating slow or high-latency links. Defaults to `-1`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
-				Validators: []validator.Int64{
-					int64validator.Between(0, 4294967295),
-				},
+				Validators:          quaggaOSPFTimerValidators,
 			},
 			"priority": schema.Int64Attribute{
 				MarkdownDescription: "Determines the likelihood of becoming a Designated Router; higher values increase priority. Defaults to `-1`.",
@@ -164,7 +253,7 @@ func quaggaOSPFInterfaceResourceSchema() schema.Schema {
 				},
 			},
 			"bfd": schema.BoolAttribute{
-				MarkdownDescription: "Activates Bidirectional Forwarding Detection for rapid link failure detection; peer configuration required. Defaults to `false`.",
+				MarkdownDescription: "Activates Bidirectional Forwarding Detection for rapid link failure detection; requires BFD to be enabled with `opnsense_quagga_bfd` and a matching `opnsense_quagga_bfd_neighbor`. Defaults to `false`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -210,8 +299,13 @@ func QuaggaOSPFInterfaceDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Defines security method for OSPF exchanges (None, plain, or MD5) to prevent unauthorized updates. Choose `MD5` or `plain`.",
 				Computed:            true,
 			},
-			"authkey": dschema.Int64Attribute{
-				MarkdownDescription: "Specifies a password or key used for plain or MD5 authentication. Defaults to `-1`.",
+			"authkey": dschema.StringAttribute{
+				MarkdownDescription: "Always null, secrets are not exposed by data sources.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"authkey_hash": dschema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the authentication key, empty when no key is set.",
 				Computed:            true,
 			},
 			"authkey_id": dschema.Int64Attribute{
@@ -275,7 +369,7 @@ func convertQuaggaOSPFInterfaceSchemaToStruct(d *QuaggaOSPFInterfaceResourceMode
 		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
 		InterfaceName: api.SelectedMap(d.InterfaceName.ValueString()),
 		AuthType:      api.SelectedMap(d.AuthType.ValueString()),
-		AuthKey:       tools.Int64ToStringNegative(d.AuthKey.ValueInt64()),
+		AuthKey:       d.AuthKey.ValueString(),
 		AuthKeyID:     tools.Int64ToString(d.AuthKeyID.ValueInt64()),
 		Area:          d.Area.ValueString(),
 		Cost:          tools.Int64ToString(d.Cost.ValueInt64()),
@@ -297,7 +391,8 @@ func convertQuaggaOSPFInterfaceStructToSchema(d *quagga.OSPFInterface) (*QuaggaO
 		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
 		InterfaceName: types.StringValue(d.InterfaceName.String()),
 		AuthType:      types.StringValue(d.AuthType.String()),
-		AuthKey:       types.Int64Value(tools.StringToInt64(d.AuthKey)),
+		AuthKey:       types.StringNull(),
+		AuthKeyHash:   types.StringValue(tools.HashSecret(d.AuthKey)),
 		AuthKeyID:     types.Int64Value(tools.StringToInt64(d.AuthKeyID)),
 		Area:          types.StringValue(d.Area),
 		Cost:          types.Int64Value(tools.StringToInt64(d.Cost)),
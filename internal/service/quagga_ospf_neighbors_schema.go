@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+)
+
+type QuaggaOSPFNeighborsDataSourceModel struct {
+	Neighbors types.List `tfsdk:"neighbors"`
+}
+
+type QuaggaOSPFNeighborModel struct {
+	NeighborID types.String `tfsdk:"neighbor_id"`
+	Address    types.String `tfsdk:"address"`
+	State      types.String `tfsdk:"state"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Interface  types.String `tfsdk:"interface"`
+	DeadTime   types.Int64  `tfsdk:"dead_time"`
+}
+
+var quaggaOSPFNeighborAttrTypes = map[string]attr.Type{
+	"neighbor_id": types.StringType,
+	"address":     types.StringType,
+	"state":       types.StringType,
+	"priority":    types.Int64Type,
+	"interface":   types.StringType,
+	"dead_time":   types.Int64Type,
+}
+
+func QuaggaOSPFNeighborsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The OSPF neighbors can be used to get the runtime state of all OSPF adjacencies, e.g. to verify in a `check` block that an adjacency has reached the `Full` state.",
+
+		Attributes: map[string]schema.Attribute{
+			"neighbors": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all OSPF neighbors, one entry per neighbor and interface.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"neighbor_id": schema.StringAttribute{
+							MarkdownDescription: "Router ID of the neighbor.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Interface address of the neighbor.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the adjacency including the neighbor role, e.g. `Full/DR` or `2-Way/DROther`.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Router priority of the neighbor.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Local device the neighbor was seen on, e.g. `vtnet1`.",
+							Computed:            true,
+						},
+						"dead_time": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds until the neighbor is declared dead when no hello is received.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertQuaggaOSPFNeighborsStructToSchema(d quagga.OSPFNeighbors) (*QuaggaOSPFNeighborsDataSourceModel, error) {
+	// Sort router IDs, so the list order is stable between reads
+	var ids []string
+	for id := range d {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	neighbors := []QuaggaOSPFNeighborModel{}
+	for _, id := range ids {
+		for _, n := range d[id] {
+			// FRR reports the interface as `<device>:<local address>`
+			iface, _, _ := strings.Cut(n.InterfaceName, ":")
+
+			neighbors = append(neighbors, QuaggaOSPFNeighborModel{
+				NeighborID: types.StringValue(id),
+				Address:    types.StringValue(n.Address),
+				State:      types.StringValue(n.State),
+				Priority:   types.Int64Value(n.Priority),
+				Interface:  types.StringValue(iface),
+				DeadTime:   types.Int64Value(n.DeadTimeMsec / 1000),
+			})
+		}
+	}
+
+	v, diags := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: quaggaOSPFNeighborAttrTypes,
+		},
+		neighbors,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert ospf neighbors: %v", diags)
+	}
+
+	return &QuaggaOSPFNeighborsDataSourceModel{
+		Neighbors: v,
+	}, nil
+}
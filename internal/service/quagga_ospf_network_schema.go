@@ -0,0 +1,154 @@
+package service
+
+import (
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// QuaggaOSPFNetworkResourceModel describes the resource data model.
+type QuaggaOSPFNetworkResourceModel struct {
+	Enabled       types.Bool            `tfsdk:"enabled"`
+	Network       customtypes.CIDRValue `tfsdk:"network"`
+	Area          types.String          `tfsdk:"area"`
+	AreaRange     types.String          `tfsdk:"area_range"`
+	PrefixListIn  types.String          `tfsdk:"prefix_list_in"`
+	PrefixListOut types.String          `tfsdk:"prefix_list_out"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func quaggaOSPFNetworkResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure networks for OSPF. Interfaces with an address in one of these networks take part in OSPF.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this network. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 network to run OSPF on, in CIDR notation (e.g. `10.0.0.0/24`).",
+				Required:            true,
+				CustomType:          customtypes.CIDRType{},
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"area": schema.StringAttribute{
+				MarkdownDescription: "The area this network belongs to, in dotted decimal (e.g. `0.0.0.0` for the backbone area) or integer notation.",
+				Required:            true,
+				Validators:          quaggaOSPFAreaValidators,
+			},
+			"area_range": schema.StringAttribute{
+				MarkdownDescription: "Summarize the routes of this area into a single route to other areas, in CIDR notation (e.g. `10.0.0.0/16`). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"prefix_list_in": schema.StringAttribute{
+				MarkdownDescription: "ID of a prefix list to filter incoming routes with. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prefix_list_out": schema.StringAttribute{
+				MarkdownDescription: "ID of a prefix list to filter outgoing routes with. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaOSPFNetworkDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure networks for OSPF.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this network is enabled.",
+				Computed:            true,
+			},
+			"network": dschema.StringAttribute{
+				MarkdownDescription: "The IPv4 network OSPF runs on, in CIDR notation.",
+				Computed:            true,
+				CustomType:          customtypes.CIDRType{},
+			},
+			"area": dschema.StringAttribute{
+				MarkdownDescription: "The area this network belongs to.",
+				Computed:            true,
+			},
+			"area_range": dschema.StringAttribute{
+				MarkdownDescription: "The range the routes of this area are summarized into.",
+				Computed:            true,
+			},
+			"prefix_list_in": dschema.StringAttribute{
+				MarkdownDescription: "ID of the prefix list incoming routes are filtered with.",
+				Computed:            true,
+			},
+			"prefix_list_out": dschema.StringAttribute{
+				MarkdownDescription: "ID of the prefix list outgoing routes are filtered with.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQuaggaOSPFNetworkSchemaToStruct(d *QuaggaOSPFNetworkResourceModel) (*quagga.OSPFNetwork, error) {
+	// OPNsense stores the network address and prefix length separately
+	prefix, ok := d.Network.ValuePrefix()
+	if !ok || !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("network must be an IPv4 network in CIDR notation, got: %s", d.Network.ValueString())
+	}
+	prefix = prefix.Masked()
+
+	return &quagga.OSPFNetwork{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		IPAddr:        prefix.Addr().String(),
+		NetMask:       tools.Int64ToString(int64(prefix.Bits())),
+		Area:          d.Area.ValueString(),
+		AreaRange:     d.AreaRange.ValueString(),
+		PrefixListIn:  api.SelectedMap(d.PrefixListIn.ValueString()),
+		PrefixListOut: api.SelectedMap(d.PrefixListOut.ValueString()),
+	}, nil
+}
+
+func convertQuaggaOSPFNetworkStructToSchema(d *quagga.OSPFNetwork) (*QuaggaOSPFNetworkResourceModel, error) {
+	return &QuaggaOSPFNetworkResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		Network:       customtypes.NewCIDRValue(fmt.Sprintf("%s/%s", d.IPAddr, d.NetMask)),
+		Area:          types.StringValue(d.Area),
+		AreaRange:     types.StringValue(d.AreaRange),
+		PrefixListIn:  types.StringValue(d.PrefixListIn.String()),
+		PrefixListOut: types.StringValue(d.PrefixListOut.String()),
+	}, nil
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// quaggaOSPFId is the ID of the OSPF singleton resource.
+const quaggaOSPFId = "ospf"
+
+// QuaggaOSPFResourceModel describes the resource data model.
+type QuaggaOSPFResourceModel struct {
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	RouterID                    types.String `tfsdk:"router_id"`
+	PassiveInterfaces           types.Set    `tfsdk:"passive_interfaces"`
+	Redistribute                types.Set    `tfsdk:"redistribute"`
+	RedistributeRouteMap        types.String `tfsdk:"redistribute_route_map"`
+	DefaultInformationOriginate types.Bool   `tfsdk:"default_information_originate"`
+	DefaultInformationAlways    types.Bool   `tfsdk:"default_information_always"`
+	DefaultInformationMetric    types.Int64  `tfsdk:"default_information_metric"`
+	ReferenceBandwidth          types.Int64  `tfsdk:"reference_bandwidth"`
+	SPFDelay                    types.Int64  `tfsdk:"spf_delay"`
+	SPFInitialHoldTime          types.Int64  `tfsdk:"spf_initial_holdtime"`
+	SPFMaximumHoldTime          types.Int64  `tfsdk:"spf_maximum_holdtime"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// quaggaOSPFRedistributionProtocols are the route sources which can be redistributed into OSPF.
+var quaggaOSPFRedistributionProtocols = []string{"bgp", "connected", "kernel", "rip", "static"}
+
+func quaggaOSPFResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the OSPF instance. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable OSPF. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"router_id": schema.StringAttribute{
+				MarkdownDescription: "Fixed router ID (an IPv4 address). When left empty, the highest IPv4 address of an interface is used. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"passive_interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces on which no OSPF hello packets are sent, while their networks are still advertised. This uses identifiers like `lan` or `opt2`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"redistribute": schema.SetAttribute{
+				MarkdownDescription: "Sources of routes to redistribute into OSPF. Any of `bgp`, `connected`, `kernel`, `rip` or `static`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(quaggaOSPFRedistributionProtocols...),
+					),
+				},
+			},
+			"redistribute_route_map": schema.StringAttribute{
+				MarkdownDescription: "ID of a route map to filter the redistributed routes with. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"default_information_originate": schema.BoolAttribute{
+				MarkdownDescription: "Advertise a default route into OSPF, if one exists in the routing table. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_information_always": schema.BoolAttribute{
+				MarkdownDescription: "Always advertise a default route, even when none exists in the routing table. Requires `default_information_originate`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_information_metric": schema.Int64Attribute{
+				MarkdownDescription: "Metric of the advertised default route. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 16777214),
+				},
+			},
+			"reference_bandwidth": schema.Int64Attribute{
+				MarkdownDescription: "Reference bandwidth in Mbit/s used to calculate interface costs. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 4294967),
+				},
+			},
+			"spf_delay": schema.Int64Attribute{
+				MarkdownDescription: "Delay in milliseconds between receiving a change and starting the SPF calculation. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 600000),
+				},
+			},
+			"spf_initial_holdtime": schema.Int64Attribute{
+				MarkdownDescription: "Initial hold time in milliseconds between consecutive SPF calculations. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 600000),
+				},
+			},
+			"spf_maximum_holdtime": schema.Int64Attribute{
+				MarkdownDescription: "Maximum hold time in milliseconds between consecutive SPF calculations. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 600000),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the OSPF settings, always `ospf`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaOSPFDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of the OSPF instance.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the OSPF settings, always `ospf`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether OSPF is enabled.",
+				Computed:            true,
+			},
+			"router_id": dschema.StringAttribute{
+				MarkdownDescription: "Fixed router ID (an IPv4 address).",
+				Computed:            true,
+			},
+			"passive_interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces on which no OSPF hello packets are sent.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redistribute": dschema.SetAttribute{
+				MarkdownDescription: "Sources of routes redistributed into OSPF.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redistribute_route_map": dschema.StringAttribute{
+				MarkdownDescription: "ID of the route map the redistributed routes are filtered with.",
+				Computed:            true,
+			},
+			"default_information_originate": dschema.BoolAttribute{
+				MarkdownDescription: "Whether a default route is advertised into OSPF.",
+				Computed:            true,
+			},
+			"default_information_always": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the default route is advertised even when none exists in the routing table.",
+				Computed:            true,
+			},
+			"default_information_metric": dschema.Int64Attribute{
+				MarkdownDescription: "Metric of the advertised default route.",
+				Computed:            true,
+			},
+			"reference_bandwidth": dschema.Int64Attribute{
+				MarkdownDescription: "Reference bandwidth in Mbit/s used to calculate interface costs.",
+				Computed:            true,
+			},
+			"spf_delay": dschema.Int64Attribute{
+				MarkdownDescription: "Delay in milliseconds between receiving a change and starting the SPF calculation.",
+				Computed:            true,
+			},
+			"spf_initial_holdtime": dschema.Int64Attribute{
+				MarkdownDescription: "Initial hold time in milliseconds between consecutive SPF calculations.",
+				Computed:            true,
+			},
+			"spf_maximum_holdtime": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum hold time in milliseconds between consecutive SPF calculations.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// quaggaOSPFDefaults returns the OSPF settings of a fresh OPNsense install, used to reset the singleton.
+func quaggaOSPFDefaults() *quagga.OSPF {
+	return &quagga.OSPF{
+		Enabled:            "0",
+		RouterID:           "",
+		CostReference:      "",
+		PassiveInterfaces:  api.SelectedMapList{},
+		Redistribute:       api.SelectedMapList{},
+		RedistributeMap:    "",
+		Originate:          "0",
+		OriginateAlways:    "0",
+		OriginateMetric:    "",
+		SPFDelay:           "",
+		SPFInitialHoldTime: "",
+		SPFMaximumHoldTime: "",
+	}
+}
+
+func convertQuaggaOSPFSchemaToStruct(d *QuaggaOSPFResourceModel) (*quagga.OSPF, error) {
+	return &quagga.OSPF{
+		Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+		RouterID:           d.RouterID.ValueString(),
+		CostReference:      tools.Int64ToStringNegative(d.ReferenceBandwidth.ValueInt64()),
+		PassiveInterfaces:  tools.SetToStringSlice(d.PassiveInterfaces),
+		Redistribute:       tools.SetToStringSlice(d.Redistribute),
+		RedistributeMap:    api.SelectedMap(d.RedistributeRouteMap.ValueString()),
+		Originate:          tools.BoolToString(d.DefaultInformationOriginate.ValueBool()),
+		OriginateAlways:    tools.BoolToString(d.DefaultInformationAlways.ValueBool()),
+		OriginateMetric:    tools.Int64ToStringNegative(d.DefaultInformationMetric.ValueInt64()),
+		SPFDelay:           tools.Int64ToStringNegative(d.SPFDelay.ValueInt64()),
+		SPFInitialHoldTime: tools.Int64ToStringNegative(d.SPFInitialHoldTime.ValueInt64()),
+		SPFMaximumHoldTime: tools.Int64ToStringNegative(d.SPFMaximumHoldTime.ValueInt64()),
+	}, nil
+}
+
+func convertQuaggaOSPFStructToSchema(d *quagga.OSPF) (*QuaggaOSPFResourceModel, error) {
+	return &QuaggaOSPFResourceModel{
+		Enabled:                     types.BoolValue(tools.StringToBool(d.Enabled)),
+		RouterID:                    types.StringValue(d.RouterID),
+		PassiveInterfaces:           tools.StringSliceToSet(d.PassiveInterfaces),
+		Redistribute:                tools.StringSliceToSet(d.Redistribute),
+		RedistributeRouteMap:        types.StringValue(d.RedistributeMap.String()),
+		DefaultInformationOriginate: types.BoolValue(tools.StringToBool(d.Originate)),
+		DefaultInformationAlways:    types.BoolValue(tools.StringToBool(d.OriginateAlways)),
+		DefaultInformationMetric:    types.Int64Value(tools.StringToInt64(d.OriginateMetric)),
+		ReferenceBandwidth:          types.Int64Value(tools.StringToInt64(d.CostReference)),
+		SPFDelay:                    types.Int64Value(tools.StringToInt64(d.SPFDelay)),
+		SPFInitialHoldTime:          types.Int64Value(tools.StringToInt64(d.SPFInitialHoldTime)),
+		SPFMaximumHoldTime:          types.Int64Value(tools.StringToInt64(d.SPFMaximumHoldTime)),
+	}, nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// quaggaRIPId is the ID of the RIP singleton resource.
+const quaggaRIPId = "rip"
+
+// QuaggaRIPResourceModel describes the resource data model.
+type QuaggaRIPResourceModel struct {
+	Enabled           types.Bool  `tfsdk:"enabled"`
+	Version           types.Int64 `tfsdk:"version"`
+	Networks          types.Set   `tfsdk:"networks"`
+	PassiveInterfaces types.Set   `tfsdk:"passive_interfaces"`
+	Redistribute      types.Set   `tfsdk:"redistribute"`
+	DefaultMetric     types.Int64 `tfsdk:"default_metric"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// quaggaRIPRedistributionProtocols are the route sources which can be redistributed into RIP.
+var quaggaRIPRedistributionProtocols = []string{"bgp", "connected", "kernel", "ospf", "static"}
+
+func quaggaRIPResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the RIP instance. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable RIP. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The RIP version to send and receive. One of `1` or `2`. Defaults to `2`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(2),
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2),
+				},
+			},
+			"networks": schema.SetAttribute{
+				MarkdownDescription: "Networks to enable RIP on, in CIDR notation (e.g. `10.0.0.0/8`). Interfaces with an address in one of these networks send and receive RIP updates. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IPv4CIDR()),
+				},
+			},
+			"passive_interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces on which no RIP updates are sent, while their networks are still advertised. This uses identifiers like `lan` or `opt2`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"redistribute": schema.SetAttribute{
+				MarkdownDescription: "Sources of routes to redistribute into RIP. Any of `bgp`, `connected`, `kernel`, `ospf` or `static`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(quaggaRIPRedistributionProtocols...),
+					),
+				},
+			},
+			"default_metric": schema.Int64Attribute{
+				MarkdownDescription: "Metric of redistributed routes. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the RIP settings, always `rip`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaRIPDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the RIP instance.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the RIP settings, always `rip`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether RIP is enabled.",
+				Computed:            true,
+			},
+			"version": dschema.Int64Attribute{
+				MarkdownDescription: "The RIP version.",
+				Computed:            true,
+			},
+			"networks": dschema.SetAttribute{
+				MarkdownDescription: "Networks RIP is enabled on.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"passive_interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces on which no RIP updates are sent.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redistribute": dschema.SetAttribute{
+				MarkdownDescription: "Sources of routes redistributed into RIP.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default_metric": dschema.Int64Attribute{
+				MarkdownDescription: "Metric of redistributed routes, `-1` if the default is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// quaggaRIPDefaults returns the RIP settings of a fresh OPNsense install, used to reset the singleton.
+func quaggaRIPDefaults() *quagga.RIP {
+	return &quagga.RIP{
+		Enabled:           "0",
+		Version:           "2",
+		Networks:          api.SelectedMapList{},
+		PassiveInterfaces: api.SelectedMapList{},
+		Redistribute:      api.SelectedMapList{},
+		DefaultMetric:     "",
+	}
+}
+
+func convertQuaggaRIPSchemaToStruct(d *QuaggaRIPResourceModel) (*quagga.RIP, error) {
+	return &quagga.RIP{
+		Enabled:           tools.BoolToString(d.Enabled.ValueBool()),
+		Version:           api.SelectedMap(tools.Int64ToString(d.Version.ValueInt64())),
+		Networks:          tools.SetToStringSlice(d.Networks),
+		PassiveInterfaces: tools.SetToStringSlice(d.PassiveInterfaces),
+		Redistribute:      tools.SetToStringSlice(d.Redistribute),
+		DefaultMetric:     tools.Int64ToStringNegative(d.DefaultMetric.ValueInt64()),
+	}, nil
+}
+
+func convertQuaggaRIPStructToSchema(d *quagga.RIP) (*QuaggaRIPResourceModel, error) {
+	return &QuaggaRIPResourceModel{
+		Enabled:           types.BoolValue(tools.StringToBool(d.Enabled)),
+		Version:           types.Int64Value(tools.StringToInt64(d.Version.String())),
+		Networks:          tools.StringSliceToSet(d.Networks),
+		PassiveInterfaces: tools.StringSliceToSet(d.PassiveInterfaces),
+		Redistribute:      tools.StringSliceToSet(d.Redistribute),
+		DefaultMetric:     types.Int64Value(tools.StringToInt64(d.DefaultMetric)),
+	}, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/opnsense/quagga"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// QuaggaStaticRouteResourceModel describes the resource data model.
+type QuaggaStaticRouteResourceModel struct {
+	Enabled     types.Bool            `tfsdk:"enabled"`
+	Description types.String          `tfsdk:"description"`
+	Network     customtypes.CIDRValue `tfsdk:"network"`
+	Gateway     types.String          `tfsdk:"gateway"`
+	Interface   types.String          `tfsdk:"interface"`
+	Distance    types.Int64           `tfsdk:"distance"`
+	BFD         types.Bool            `tfsdk:"bfd"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func quaggaStaticRouteResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure static routes in FRR. Unlike the system routes managed by `opnsense_route`, these can be redistributed into BGP, OSPF and RIP.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this route. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An optional description for this route. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Destination network of this route, in CIDR notation (e.g. `10.0.0.0/24` or `2001:db8::/32`). Host bits are cleared before the route is saved.",
+				Required:            true,
+				CustomType:          customtypes.CIDRType{},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "IP of the next hop. Its address family must match `network`. At least one of `gateway` or `interface` must be set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPAddress(),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("interface")),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface to send traffic out of. This uses an identifier like `lan` or `opt2`. When set together with `gateway`, the next hop must be reachable on this interface. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"distance": schema.Int64Attribute{
+				MarkdownDescription: "Administrative distance of this route; routes with a lower distance are preferred over routes learned from other sources. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"bfd": schema.BoolAttribute{
+				MarkdownDescription: "Track the reachability of `gateway` with BFD and withdraw the route when the session goes down. Requires BFD to be enabled with `opnsense_quagga_bfd`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the route.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func QuaggaStaticRouteDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure static routes in FRR.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the route.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this route is enabled.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "An optional description for this route.",
+				Computed:            true,
+			},
+			"network": dschema.StringAttribute{
+				MarkdownDescription: "Destination network of this route.",
+				Computed:            true,
+				CustomType:          customtypes.CIDRType{},
+			},
+			"gateway": dschema.StringAttribute{
+				MarkdownDescription: "IP of the next hop.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "The interface to send traffic out of.",
+				Computed:            true,
+			},
+			"distance": dschema.Int64Attribute{
+				MarkdownDescription: "Administrative distance of this route, `-1` if the default is used.",
+				Computed:            true,
+			},
+			"bfd": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the reachability of the gateway is tracked with BFD.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertQuaggaStaticRouteSchemaToStruct(d *QuaggaStaticRouteResourceModel) (*quagga.StaticRoute, error) {
+	return &quagga.StaticRoute{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Description:   d.Description.ValueString(),
+		Network:       d.Network.ValueMasked(),
+		Gateway:       d.Gateway.ValueString(),
+		InterfaceName: api.SelectedMap(d.Interface.ValueString()),
+		Distance:      tools.Int64ToStringNegative(d.Distance.ValueInt64()),
+		BFD:           tools.BoolToString(d.BFD.ValueBool()),
+	}, nil
+}
+
+func convertQuaggaStaticRouteStructToSchema(d *quagga.StaticRoute) (*QuaggaStaticRouteResourceModel, error) {
+	return &QuaggaStaticRouteResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description: types.StringValue(d.Description),
+		Network:     customtypes.NewCIDRValue(d.Network),
+		Gateway:     types.StringValue(d.Gateway),
+		Interface:   types.StringValue(d.InterfaceName.String()),
+		Distance:    types.Int64Value(tools.StringToInt64(d.Distance)),
+		BFD:         types.BoolValue(tools.StringToBool(d.BFD)),
+	}, nil
+}
@@ -4,18 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RouteResource{}
 var _ resource.ResourceWithImportState = &RouteResource{}
+var _ resource.ResourceWithModifyPlan = &RouteResource{}
 
 func NewRouteResource() resource.Resource {
 	return &RouteResource{}
@@ -40,16 +40,16 @@ func (r *RouteResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -177,6 +177,59 @@ func (r *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *RouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or before the provider has been configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data *RouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, ok := data.Network.ValuePrefix()
+	if !ok || data.Gateway.IsUnknown() {
+		return
+	}
+
+	// Look up the gateway's protocol
+	gateway, err := r.client.Routes().GetGatewayByName(ctx, data.Gateway.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("gateway"),
+				"Gateway Not Found",
+				fmt.Sprintf("Gateway %s does not exist.", data.Gateway.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to look up gateway %s, got error: %s", data.Gateway.ValueString(), err))
+		return
+	}
+
+	networkProtocol := "inet"
+	if network.Addr().Is6() {
+		networkProtocol = "inet6"
+	}
+
+	if gateway.IPProtocol != "" && gateway.IPProtocol != networkProtocol {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("network"),
+			"Address Family Mismatch",
+			fmt.Sprintf("The network %s is %s, but gateway %s is %s. The network and gateway must use the same address family.",
+				data.Network.ValueString(), networkProtocol, gateway.Name, gateway.IPProtocol),
+		)
+	}
+}
+
 func (r *RouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -9,15 +9,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/tools"
 )
 
 // RouteResourceModel describes the resource data model.
 type RouteResourceModel struct {
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	Description types.String `tfsdk:"description"`
-	Gateway     types.String `tfsdk:"gateway"`
-	Network     types.String `tfsdk:"network"`
+	Enabled     types.Bool            `tfsdk:"enabled"`
+	Description types.String          `tfsdk:"description"`
+	Gateway     types.String          `tfsdk:"gateway"`
+	Network     customtypes.CIDRValue `tfsdk:"network"`
 
 	Id types.String `tfsdk:"id"`
 }
@@ -42,8 +43,9 @@ func RouteResourceSchema() schema.Schema {
 				Required:            true,
 			},
 			"network": schema.StringAttribute{
-				MarkdownDescription: "Destination network for this static route.",
+				MarkdownDescription: "Destination network for this static route, in CIDR notation (e.g. `10.0.0.0/24` or `2001:db8::/32`). Host bits are cleared before the route is saved. Its address family must match the protocol of `gateway`.",
 				Required:            true,
+				CustomType:          customtypes.CIDRType{},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -80,6 +82,7 @@ func RouteDataSourceSchema() dschema.Schema {
 			"network": dschema.StringAttribute{
 				MarkdownDescription: "Destination network for this static route.",
 				Computed:            true,
+				CustomType:          customtypes.CIDRType{},
 			},
 		},
 	}
@@ -90,7 +93,7 @@ func convertRouteSchemaToStruct(d *RouteResourceModel) (*routes.Route, error) {
 		Disabled:    tools.BoolToString(!d.Enabled.ValueBool()),
 		Description: d.Description.ValueString(),
 		Gateway:     api.SelectedMap(d.Gateway.ValueString()),
-		Network:     d.Network.ValueString(),
+		Network:     d.Network.ValueMasked(),
 	}, nil
 }
 
@@ -99,6 +102,6 @@ func convertRouteStructToSchema(d *routes.Route) (*RouteResourceModel, error) {
 		Enabled:     types.BoolValue(!tools.StringToBool(d.Disabled)),
 		Description: tools.StringOrNull(d.Description),
 		Gateway:     types.StringValue(d.Gateway.String()),
-		Network:     types.StringValue(d.Network),
+		Network:     customtypes.NewCIDRValue(d.Network),
 	}, nil
 }
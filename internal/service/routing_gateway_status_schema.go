@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/routes"
+)
+
+type RoutingGatewayStatusDataSourceModel struct {
+	Gateways types.List `tfsdk:"gateways"`
+}
+
+type RoutingGatewayStatusModel struct {
+	Name             types.String  `tfsdk:"name"`
+	Address          types.String  `tfsdk:"address"`
+	Status           types.String  `tfsdk:"status"`
+	StatusTranslated types.String  `tfsdk:"status_translated"`
+	RTT              types.Float64 `tfsdk:"rtt"`
+	RTTd             types.Float64 `tfsdk:"rttd"`
+	Loss             types.Float64 `tfsdk:"loss"`
+	Monitor          types.String  `tfsdk:"monitor"`
+}
+
+var routingGatewayStatusAttrTypes = map[string]attr.Type{
+	"name":              types.StringType,
+	"address":           types.StringType,
+	"status":            types.StringType,
+	"status_translated": types.StringType,
+	"rtt":               types.Float64Type,
+	"rttd":              types.Float64Type,
+	"loss":              types.Float64Type,
+	"monitor":           types.StringType,
+}
+
+func RoutingGatewayStatusDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Gateway status can be used to get the current state of all gateways, as reported by the gateway monitoring (dpinger) daemon.",
+
+		Attributes: map[string]schema.Attribute{
+			"gateways": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all gateways and their status.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the gateway, e.g. `WAN_DHCP`.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "IP address of the gateway.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the gateway. One of `none` (online), `down`, `loss`, `delay`, `delay+loss` or `force_down`.",
+							Computed:            true,
+						},
+						"status_translated": schema.StringAttribute{
+							MarkdownDescription: "Human readable status of the gateway, e.g. `Online`.",
+							Computed:            true,
+						},
+						"rtt": schema.Float64Attribute{
+							MarkdownDescription: "Round trip time to the monitor address in milliseconds. Null if the gateway is not monitored.",
+							Computed:            true,
+						},
+						"rttd": schema.Float64Attribute{
+							MarkdownDescription: "Standard deviation of the round trip time in milliseconds. Null if the gateway is not monitored.",
+							Computed:            true,
+						},
+						"loss": schema.Float64Attribute{
+							MarkdownDescription: "Packet loss to the monitor address in percent. Null if the gateway is not monitored.",
+							Computed:            true,
+						},
+						"monitor": schema.StringAttribute{
+							MarkdownDescription: "IP address used to monitor the gateway.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseGatewayStatusMetric converts a dpinger metric (e.g. `0.4 ms` or `0.0 %`) to a float. Unmonitored
+// gateways report `~`, which is converted to null.
+func parseGatewayStatusMetric(s string) types.Float64 {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(s, "ms"), "%"))
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return types.Float64Null()
+	}
+	return types.Float64Value(f)
+}
+
+func convertRoutingGatewayStatusStructToSchema(d []routes.GatewayStatus) (*RoutingGatewayStatusDataSourceModel, error) {
+	gateways := []RoutingGatewayStatusModel{}
+	for _, gw := range d {
+		gateways = append(gateways, RoutingGatewayStatusModel{
+			Name:             types.StringValue(gw.Name),
+			Address:          types.StringValue(gw.Address),
+			Status:           types.StringValue(gw.Status),
+			StatusTranslated: types.StringValue(gw.StatusTranslated),
+			RTT:              parseGatewayStatusMetric(gw.Delay),
+			RTTd:             parseGatewayStatusMetric(gw.StdDev),
+			Loss:             parseGatewayStatusMetric(gw.Loss),
+			Monitor:          types.StringValue(gw.Monitor),
+		})
+	}
+
+	v, diags := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: routingGatewayStatusAttrTypes,
+		},
+		gateways,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert gateway status: %v", diags)
+	}
+
+	return &RoutingGatewayStatusDataSourceModel{
+		Gateways: v,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/diagnostics"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type RoutingTableDataSourceModel struct {
+	Proto  types.String `tfsdk:"proto"`
+	Routes types.List   `tfsdk:"routes"`
+}
+
+type RoutingTableRouteModel struct {
+	Proto                types.String `tfsdk:"proto"`
+	Destination          types.String `tfsdk:"destination"`
+	Gateway              types.String `tfsdk:"gateway"`
+	Flags                types.String `tfsdk:"flags"`
+	MTU                  types.Int64  `tfsdk:"mtu"`
+	Interface            types.String `tfsdk:"interface"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	Expire               types.String `tfsdk:"expire"`
+}
+
+var routingTableRouteAttrTypes = map[string]attr.Type{
+	"proto":                 types.StringType,
+	"destination":           types.StringType,
+	"gateway":               types.StringType,
+	"flags":                 types.StringType,
+	"mtu":                   types.Int64Type,
+	"interface":             types.StringType,
+	"interface_description": types.StringType,
+	"expire":                types.StringType,
+}
+
+func RoutingTableDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The routing table can be used to get the routes currently installed in the kernel, including those learned through dynamic routing (e.g. FRR).",
+
+		Attributes: map[string]schema.Attribute{
+			"proto": schema.StringAttribute{
+				MarkdownDescription: "Only return routes of this address family. Available values: `ipv4`, `ipv6`. Returns both when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ipv4", "ipv6"),
+				},
+			},
+			"routes": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all routes in the kernel routing table.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"proto": schema.StringAttribute{
+							MarkdownDescription: "Address family of the route, `ipv4` or `ipv6`.",
+							Computed:            true,
+						},
+						"destination": schema.StringAttribute{
+							MarkdownDescription: "Destination network of the route, e.g. `10.0.0.0/24` or `default`.",
+							Computed:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Next hop of the route. Either an IP address or a link (e.g. `link#1`).",
+							Computed:            true,
+						},
+						"flags": schema.StringAttribute{
+							MarkdownDescription: "Route flags as reported by `netstat`, e.g. `UGS`.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the route.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "Device the route is bound to, e.g. `vtnet0`.",
+							Computed:            true,
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the interface the route is bound to, e.g. `WAN`.",
+							Computed:            true,
+						},
+						"expire": schema.StringAttribute{
+							MarkdownDescription: "Time until the route expires. Empty for routes that do not expire.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertRoutingTableStructToSchema(d []diagnostics.Route, proto string) (*RoutingTableDataSourceModel, error) {
+	routes := []RoutingTableRouteModel{}
+	for _, route := range d {
+		// Filter on address family, if requested
+		if proto != "" && route.Proto != proto {
+			continue
+		}
+
+		routes = append(routes, RoutingTableRouteModel{
+			Proto:                types.StringValue(route.Proto),
+			Destination:          types.StringValue(route.Destination),
+			Gateway:              types.StringValue(route.Gateway),
+			Flags:                types.StringValue(route.Flags),
+			MTU:                  tools.StringToInt64Null(route.MTU),
+			Interface:            types.StringValue(route.Interface),
+			InterfaceDescription: types.StringValue(route.InterfaceDescription),
+			Expire:               types.StringValue(route.Expire),
+		})
+	}
+
+	v, diags := types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: routingTableRouteAttrTypes,
+		},
+		routes,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to convert routing table: %v", diags)
+	}
+
+	return &RoutingTableDataSourceModel{
+		Proto:  tools.StringOrNull(proto),
+		Routes: v,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// UnboundACLResourceModel describes the resource data model.
+type UnboundACLResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Name        types.String `tfsdk:"name"`
+	Action      types.String `tfsdk:"action"`
+	Networks    types.Set    `tfsdk:"networks"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// unboundACLActions are the access list actions. OPNsense spells the nonlocal
+// actions as `deny_non_local` and `refuse_non_local`.
+var unboundACLActions = []string{"allow", "deny", "refuse", "allow_snoop", "deny_nonlocal", "refuse_nonlocal"}
+
+func unboundACLResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Access lists define which networks may query the Unbound resolver. Networks not covered by an access list are refused, except for the networks of the interfaces Unbound listens on.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this access list. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of this access list.",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "How to answer queries from the networks of this access list. One of `allow`, `deny` (drop), `refuse` (answer with REFUSED), `allow_snoop` (also allow non-recursive queries), `deny_nonlocal` or `refuse_nonlocal` (only allow queries for local data).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(unboundACLActions...),
+				},
+			},
+			"networks": schema.SetAttribute{
+				MarkdownDescription: "Networks this access list applies to, in CIDR notation (e.g. `10.0.0.0/24` or `2001:db8::/64`).",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.CIDR()),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the access list.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func UnboundACLDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Access lists define which networks may query the Unbound resolver.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the access list.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this access list is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "The name of this access list.",
+				Computed:            true,
+			},
+			"action": dschema.StringAttribute{
+				MarkdownDescription: "How queries from the networks of this access list are answered.",
+				Computed:            true,
+			},
+			"networks": dschema.SetAttribute{
+				MarkdownDescription: "Networks this access list applies to.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertUnboundACLSchemaToStruct(d *UnboundACLResourceModel) (*unbound.ACL, error) {
+	return &unbound.ACL{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Name:        d.Name.ValueString(),
+		Action:      api.SelectedMap(strings.Replace(d.Action.ValueString(), "_nonlocal", "_non_local", 1)),
+		Networks:    tools.SetToStringSlice(d.Networks),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertUnboundACLStructToSchema(d *unbound.ACL) (*UnboundACLResourceModel, error) {
+	return &UnboundACLResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:        types.StringValue(d.Name),
+		Action:      types.StringValue(strings.Replace(d.Action.String(), "_non_local", "_nonlocal", 1)),
+		Networks:    tools.StringSliceToSet(d.Networks),
+		Description: types.StringValue(d.Description),
+	}, nil
+}
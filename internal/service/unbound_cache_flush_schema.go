@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UnboundCacheFlushResourceModel describes the resource data model.
+type UnboundCacheFlushResourceModel struct {
+	Triggers types.Map `tfsdk:"triggers"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func unboundCacheFlushResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Flush the Unbound cache, e.g. after a host override was changed. This is an action, not an object on the firewall: the cache is flushed when this resource is created and whenever `triggers` change. Reading it does not contact the firewall and destroying it does nothing. OPNsense has no API action to flush a single domain, so the cache is flushed by restarting Unbound with the `service/restart` action of its API, which clears the whole cache and briefly interrupts name resolution.",
+
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which flush the cache again when changed, e.g. the attributes of a host override.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Random ID of the cache flush.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
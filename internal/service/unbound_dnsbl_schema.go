@@ -0,0 +1,217 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// UnboundDNSBLResourceModel describes the resource data model.
+type UnboundDNSBLResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Description        types.String `tfsdk:"description"`
+	PredefinedLists    types.Set    `tfsdk:"predefined_lists"`
+	CustomLists        types.Set    `tfsdk:"custom_lists"`
+	AllowlistDomains   types.Set    `tfsdk:"allowlist_domains"`
+	BlocklistDomains   types.Set    `tfsdk:"blocklist_domains"`
+	WildcardDomains    types.Set    `tfsdk:"wildcard_domains"`
+	SourceNetworks     types.Set    `tfsdk:"source_networks"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	NXDomain           types.Bool   `tfsdk:"nxdomain"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func unboundDNSBLResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "DNS blocklists (DNSBL) answer queries for listed domains with a fixed address or NXDOMAIN, e.g. to block ads and malware network-wide. The lists are downloaded and applied after every change.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this blocklist. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"predefined_lists": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the predefined lists shipped with OPNsense to enable (e.g. `ag` for AdGuard or `el` for EasyList). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"custom_lists": schema.SetAttribute{
+				MarkdownDescription: "URLs of additional blocklists to download. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^(https?|file)://`), "must be a http(s) or file URL"),
+					),
+				},
+			},
+			"allowlist_domains": schema.SetAttribute{
+				MarkdownDescription: "Domains to exclude from the blocklists. Regular expressions are supported. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"blocklist_domains": schema.SetAttribute{
+				MarkdownDescription: "Additional domains to block. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"wildcard_domains": schema.SetAttribute{
+				MarkdownDescription: "Additional domains to block including all of their subdomains. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"source_networks": schema.SetAttribute{
+				MarkdownDescription: "Client networks this blocklist applies to, in CIDR notation. Applies to all clients when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.CIDR()),
+				},
+			},
+			"destination_address": schema.StringAttribute{
+				MarkdownDescription: "Address returned for blocked domains. Uses `0.0.0.0` when empty. Ignored when `nxdomain` is set. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"nxdomain": schema.BoolAttribute{
+				MarkdownDescription: "Answer queries for blocked domains with NXDOMAIN instead of `destination_address`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the blocklist.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func UnboundDNSBLDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "DNS blocklists (DNSBL) answer queries for listed domains with a fixed address or NXDOMAIN.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the blocklist.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this blocklist is enabled.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"predefined_lists": dschema.SetAttribute{
+				MarkdownDescription: "Identifiers of the enabled predefined lists.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_lists": dschema.SetAttribute{
+				MarkdownDescription: "URLs of additional blocklists.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowlist_domains": dschema.SetAttribute{
+				MarkdownDescription: "Domains excluded from the blocklists.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"blocklist_domains": dschema.SetAttribute{
+				MarkdownDescription: "Additional blocked domains.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"wildcard_domains": dschema.SetAttribute{
+				MarkdownDescription: "Additional blocked domains including all of their subdomains.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_networks": dschema.SetAttribute{
+				MarkdownDescription: "Client networks this blocklist applies to, all clients when empty.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"destination_address": dschema.StringAttribute{
+				MarkdownDescription: "Address returned for blocked domains.",
+				Computed:            true,
+			},
+			"nxdomain": dschema.BoolAttribute{
+				MarkdownDescription: "Whether queries for blocked domains are answered with NXDOMAIN.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertUnboundDNSBLSchemaToStruct(d *UnboundDNSBLResourceModel) (*unbound.DNSBL, error) {
+	return &unbound.DNSBL{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Description: d.Description.ValueString(),
+		Type:        tools.SetToStringSlice(d.PredefinedLists),
+		Lists:       tools.SetToStringSlice(d.CustomLists),
+		AllowLists:  tools.SetToStringSlice(d.AllowlistDomains),
+		BlockLists:  tools.SetToStringSlice(d.BlocklistDomains),
+		Wildcards:   tools.SetToStringSlice(d.WildcardDomains),
+		SourceNets:  tools.SetToStringSlice(d.SourceNetworks),
+		Address:     d.DestinationAddress.ValueString(),
+		NXDomain:    tools.BoolToString(d.NXDomain.ValueBool()),
+	}, nil
+}
+
+func convertUnboundDNSBLStructToSchema(d *unbound.DNSBL) (*UnboundDNSBLResourceModel, error) {
+	return &UnboundDNSBLResourceModel{
+		Enabled:            types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:        types.StringValue(d.Description),
+		PredefinedLists:    tools.StringSliceToSet(d.Type),
+		CustomLists:        tools.StringSliceToSet(d.Lists),
+		AllowlistDomains:   tools.StringSliceToSet(d.AllowLists),
+		BlocklistDomains:   tools.StringSliceToSet(d.BlockLists),
+		WildcardDomains:    tools.StringSliceToSet(d.Wildcards),
+		SourceNetworks:     tools.StringSliceToSet(d.SourceNets),
+		DestinationAddress: types.StringValue(d.Address),
+		NXDomain:           types.BoolValue(tools.StringToBool(d.NXDomain)),
+	}, nil
+}
@@ -3,9 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -34,20 +33,20 @@ func (d *UnboundForwardDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = opnsense.NewClient(apiClient)
+	d.client = client
 }
 
 func (d *UnboundForwardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *UnboundForwardResourceModel
+	var data *UnboundForwardDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -72,9 +71,17 @@ func (d *UnboundForwardDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	// ID cannot be added by convert... func, have to add here
-	resourceModel.Id = data.Id
+	// The data source has no resource only attributes, ID cannot be added by convert... func, have to add here
+	dataSourceModel := &UnboundForwardDataSourceModel{
+		Enabled:    resourceModel.Enabled,
+		Domain:     resourceModel.Domain,
+		Type:       resourceModel.Type,
+		ServerIP:   resourceModel.ServerIP,
+		ServerPort: resourceModel.ServerPort,
+		VerifyCN:   resourceModel.VerifyCN,
+		Id:         data.Id,
+	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &dataSourceModel)...)
 }
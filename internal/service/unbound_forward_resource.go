@@ -4,18 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UnboundForwardResource{}
 var _ resource.ResourceWithImportState = &UnboundForwardResource{}
+var _ resource.ResourceWithValidateConfig = &UnboundForwardResource{}
 
 func NewUnboundForwardResource() resource.Resource {
 	return &UnboundForwardResource{}
@@ -40,16 +40,16 @@ func (r *UnboundForwardResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *UnboundForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -78,6 +78,16 @@ func (r *UnboundForwardResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Turn off forwarding to the system nameservers, so the forward is used
+	if data.DisableSystemNameserverForwarding.ValueBool() {
+		err = r.client.Unbound().DisableSystemNameserverForwarding(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to disable system nameserver forwarding, got error: %s", err))
+			return
+		}
+	}
+
 	// Tag new resource with ID from OPNsense
 	data.Id = types.StringValue(id)
 
@@ -124,6 +134,9 @@ func (r *UnboundForwardResource) Read(ctx context.Context, req resource.ReadRequ
 	// ID cannot be added by convert... func, have to add here
 	forwardModel.Id = data.Id
 
+	// Not stored in OPNsense, keep the configured value
+	forwardModel.DisableSystemNameserverForwarding = types.BoolValue(data.DisableSystemNameserverForwarding.ValueBool())
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &forwardModel)...)
 }
@@ -154,6 +167,16 @@ func (r *UnboundForwardResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Turn off forwarding to the system nameservers, so the forward is used
+	if data.DisableSystemNameserverForwarding.ValueBool() {
+		err = r.client.Unbound().DisableSystemNameserverForwarding(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to disable system nameserver forwarding, got error: %s", err))
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -177,6 +200,33 @@ func (r *UnboundForwardResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+func (r *UnboundForwardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *UnboundForwardResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Forwarding to the system nameservers only takes precedence over forwards without a domain
+	if data.DisableSystemNameserverForwarding.ValueBool() && !data.Domain.IsUnknown() && data.Domain.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("disable_system_nameserver_forwarding"), "Invalid Attribute Combination",
+			"Attribute disable_system_nameserver_forwarding can only be true when domain is empty.")
+	}
+
+	if data.Type.ValueString() != "dot" {
+		return
+	}
+
+	// Without a common name the certificate of the server cannot be verified
+	if !data.VerifyCN.IsUnknown() && data.VerifyCN.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("verify_cn"), "Missing Verify CN",
+			"Attribute verify_cn must be set when type is dot.")
+	}
+}
+
 func (r *UnboundForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
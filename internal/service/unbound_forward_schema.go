@@ -1,7 +1,9 @@
 package service
 
 import (
+	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -9,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-opnsense/internal/tools"
 )
@@ -17,6 +20,22 @@ import (
 type UnboundForwardResourceModel struct {
 	Enabled    types.Bool   `tfsdk:"enabled"`
 	Domain     types.String `tfsdk:"domain"`
+	Type       types.String `tfsdk:"type"`
+	ServerIP   types.String `tfsdk:"server_ip"`
+	ServerPort types.Int64  `tfsdk:"server_port"`
+	VerifyCN   types.String `tfsdk:"verify_cn"`
+
+	DisableSystemNameserverForwarding types.Bool `tfsdk:"disable_system_nameserver_forwarding"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// UnboundForwardDataSourceModel describes the data source data model. Unlike the resource, it has no
+// disable_system_nameserver_forwarding, as that only controls what the resource changes.
+type UnboundForwardDataSourceModel struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Domain     types.String `tfsdk:"domain"`
+	Type       types.String `tfsdk:"type"`
 	ServerIP   types.String `tfsdk:"server_ip"`
 	ServerPort types.Int64  `tfsdk:"server_port"`
 	VerifyCN   types.String `tfsdk:"verify_cn"`
@@ -26,7 +45,7 @@ type UnboundForwardResourceModel struct {
 
 func unboundForwardResourceSchema() schema.Schema {
 	return schema.Schema{
-		MarkdownDescription: "Query Forwarding section allows for entering arbitrary nameservers to forward queries to. Can forward queries normally, or over TLS.",
+		MarkdownDescription: "Query Forwarding section allows for entering arbitrary nameservers to forward queries to. Can forward queries normally, or over TLS. A forward without a domain is only used while forwarding to the system nameservers is turned off, since that takes precedence. Set `disable_system_nameserver_forwarding` to turn it off when the forward is created or updated.",
 
 		Attributes: map[string]schema.Attribute{
 			"enabled": schema.BoolAttribute{
@@ -39,6 +58,15 @@ func unboundForwardResourceSchema() schema.Schema {
 				MarkdownDescription: "If a domain is entered here, queries for this specific domain will be forwarded to the specified server. Set to `\"\"` to forward all queries to the specified server.",
 				Required:            true,
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "How queries are forwarded to the server. Available values: `forward`, `dot` (DNS-over-TLS). Defaults to `forward`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("forward"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("forward", "dot"),
+				},
+			},
 			"server_ip": schema.StringAttribute{
 				MarkdownDescription: "IP address of DNS server to forward all requests.",
 				Required:            true,
@@ -50,11 +78,17 @@ func unboundForwardResourceSchema() schema.Schema {
 				Default:             int64default.StaticInt64(53),
 			},
 			"verify_cn": schema.StringAttribute{
-				MarkdownDescription: "The Common Name of the DNS server (e.g. `dns.example.com`). This field is required to verify its TLS certificate. DNS-over-TLS is susceptible to man-in-the-middle attacks unless certificates can be verified. Set to `\"\"` to accept self-signed yet also potentially fraudulent certificates. Must be set when `type` is `dot`.",
+				MarkdownDescription: "The Common Name of the DNS server (e.g. `dns.example.com`). This field is required to verify its TLS certificate. DNS-over-TLS is susceptible to man-in-the-middle attacks unless certificates can be verified. Must be set when `type` is `dot`. Defaults to `\"\"`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"disable_system_nameserver_forwarding": schema.BoolAttribute{
+				MarkdownDescription: "Turn off forwarding to the system nameservers (`forward_to_system_nameservers` of `opnsense_unbound_settings`) when this forward is created or updated, so that it is used. Only valid without a domain. Do not combine with `forward_to_system_nameservers = true` in `opnsense_unbound_settings`, as each would keep reverting the other. Destroying this resource does not turn the setting back on. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the forward.",
@@ -83,6 +117,10 @@ func UnboundForwardDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "If a domain is entered here, queries for this specific domain will be forwarded to the specified server.",
 				Computed:            true,
 			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "How queries are forwarded to the server, either `forward` or `dot` (DNS-over-TLS).",
+				Computed:            true,
+			},
 			"server_ip": dschema.StringAttribute{
 				MarkdownDescription: "IP address of DNS server to forward all requests.",
 				Computed:            true,
@@ -103,6 +141,7 @@ func convertUnboundForwardSchemaToStruct(d *UnboundForwardResourceModel) (*unbou
 	return &unbound.Forward{
 		Enabled:  tools.BoolToString(d.Enabled.ValueBool()),
 		Domain:   d.Domain.ValueString(),
+		Type:     api.SelectedMap(d.Type.ValueString()),
 		Server:   d.ServerIP.ValueString(),
 		Port:     tools.Int64ToString(d.ServerPort.ValueInt64()),
 		VerifyCN: d.VerifyCN.ValueString(),
@@ -113,6 +152,7 @@ func convertUnboundForwardStructToSchema(d *unbound.Forward) (*UnboundForwardRes
 	return &UnboundForwardResourceModel{
 		Enabled:    types.BoolValue(tools.StringToBool(d.Enabled)),
 		Domain:     types.StringValue(d.Domain),
+		Type:       types.StringValue(d.Type.String()),
 		ServerIP:   types.StringValue(d.Server),
 		ServerPort: types.Int64Value(tools.StringToInt64(d.Port)),
 		VerifyCN:   types.StringValue(d.VerifyCN),
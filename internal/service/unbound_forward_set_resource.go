@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UnboundForwardSetResource{}
+var _ resource.ResourceWithImportState = &UnboundForwardSetResource{}
+var _ resource.ResourceWithValidateConfig = &UnboundForwardSetResource{}
+var _ resource.ResourceWithModifyPlan = &UnboundForwardSetResource{}
+
+func NewUnboundForwardSetResource() resource.Resource {
+	return &UnboundForwardSetResource{}
+}
+
+// UnboundForwardSetResource defines the resource implementation.
+type UnboundForwardSetResource struct {
+	client opnsense.Client
+}
+
+func (r *UnboundForwardSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_forward_set"
+}
+
+func (r *UnboundForwardSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = unboundForwardSetResourceSchema()
+}
+
+func (r *UnboundForwardSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ownedForwardIDs returns the UUIDs of the forwards created by this resource, as recorded in its servers.
+func (r *UnboundForwardSetResource) ownedForwardIDs(ctx context.Context, d *UnboundForwardSetResourceModel) []string {
+	if d.Servers.IsNull() || d.Servers.IsUnknown() {
+		return nil
+	}
+
+	var servers []UnboundForwardSetServerModel
+	d.Servers.ElementsAs(ctx, &servers, false)
+
+	var ids []string
+	for _, server := range servers {
+		if !server.Id.IsNull() && !server.Id.IsUnknown() {
+			ids = append(ids, server.Id.ValueString())
+		}
+	}
+	return ids
+}
+
+// setForwardIDs records the UUID of the forward backing each server, in order.
+func (r *UnboundForwardSetResource) setForwardIDs(ctx context.Context, d *UnboundForwardSetResourceModel, ids []string) {
+	var servers []UnboundForwardSetServerModel
+	d.Servers.ElementsAs(ctx, &servers, false)
+
+	for i := range servers {
+		servers[i].Id = types.StringValue(ids[i])
+	}
+
+	d.Servers, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: unboundForwardSetServerTypes}, servers)
+}
+
+// getForwards returns the UUIDs and forwards matching keep, in the order they are configured in Unbound.
+func (r *UnboundForwardSetResource) getForwards(ctx context.Context, keep func(id string, forward *unbound.Forward) bool) ([]string, []*unbound.Forward, error) {
+	ids, all, err := r.client.Unbound().GetForwardAll(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read forward: %w", err)
+	}
+
+	var keptIds []string
+	var forwards []*unbound.Forward
+	for _, id := range ids {
+		if !keep(id, all[id]) {
+			continue
+		}
+		keptIds = append(keptIds, id)
+		forwards = append(forwards, all[id])
+	}
+
+	return keptIds, forwards, nil
+}
+
+// reconcileForwards adds, updates and deletes the forwards owned by this set, so that they match the wanted forwards
+// in order. Forwards are updated in place, since Unbound uses them in the order they are configured. Forwards which
+// already match are left untouched, and forwards which are not owned are never changed. It returns the UUID of the
+// forward backing each wanted forward.
+func (r *UnboundForwardSetResource) reconcileForwards(ctx context.Context, owned []string, wanted []*unbound.Forward) ([]string, error) {
+	ownedIds := map[string]bool{}
+	for _, id := range owned {
+		ownedIds[id] = true
+	}
+
+	existingIds, existing, err := r.getForwards(ctx, func(id string, forward *unbound.Forward) bool {
+		return ownedIds[id]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for i, forward := range wanted {
+		if i >= len(existingIds) {
+			id, err := r.client.Unbound().AddForward(ctx, forward)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create forward: %w", err)
+			}
+			ids = append(ids, id)
+			continue
+		}
+
+		ids = append(ids, existingIds[i])
+		if *existing[i] == *forward {
+			continue
+		}
+		if err := r.client.Unbound().UpdateForward(ctx, existingIds[i], forward); err != nil {
+			return nil, fmt.Errorf("unable to update forward: %w", err)
+		}
+	}
+
+	// Delete owned forwards no longer wanted
+	for i := len(wanted); i < len(existingIds); i++ {
+		if err := r.client.Unbound().DeleteForward(ctx, existingIds[i]); err != nil {
+			return nil, fmt.Errorf("unable to delete forward: %w", err)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *UnboundForwardSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UnboundForwardSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	forwards, err := convertUnboundForwardSetSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse forward set, got error: %s", err))
+		return
+	}
+
+	// Add forwards to unbound
+	ids, err := r.reconcileForwards(ctx, nil, forwards)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create forward set, got error: %s", err))
+		return
+	}
+	r.setForwardIDs(ctx, data, ids)
+
+	// Turn off forwarding to the system nameservers, so the set is used
+	if data.DisableSystemNameserverForwarding.ValueBool() {
+		err = r.client.Unbound().DisableSystemNameserverForwarding(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to disable system nameserver forwarding, got error: %s", err))
+			return
+		}
+	}
+
+	// The domain identifies the forwards of the set
+	data.Id = types.StringValue(unboundForwardSetIDFromDomain(data.Domain.ValueString()))
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UnboundForwardSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UnboundForwardSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get forwards from OPNsense unbound API
+	domain := unboundForwardSetDomainFromID(data.Id.ValueString())
+	owned := map[string]bool{}
+	for _, id := range r.ownedForwardIDs(ctx, data) {
+		owned[id] = true
+	}
+	ids, forwards, err := r.getForwards(ctx, func(id string, forward *unbound.Forward) bool {
+		// An imported set, or one created before forwards were tracked, adopts all forwards with its domain and type
+		if len(owned) == 0 {
+			return forward.Domain == domain && forward.Type.String() == data.Type.ValueString()
+		}
+		return owned[id]
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read forward set, got error: %s", err))
+		return
+	}
+
+	if len(forwards) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("forward set not present in remote, removing from state"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	forwardSetModel, err := convertUnboundForwardSetStructToSchema(domain, ids, forwards)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read forward set, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	forwardSetModel.Id = data.Id
+
+	// Not stored in OPNsense, keep the configured value
+	forwardSetModel.DisableSystemNameserverForwarding = types.BoolValue(data.DisableSystemNameserverForwarding.ValueBool())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &forwardSetModel)...)
+}
+
+func (r *UnboundForwardSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *UnboundForwardSetResourceModel
+	var state *UnboundForwardSetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	forwards, err := convertUnboundForwardSetSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse forward set, got error: %s", err))
+		return
+	}
+
+	// Update forwards in unbound
+	ids, err := r.reconcileForwards(ctx, r.ownedForwardIDs(ctx, state), forwards)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update forward set, got error: %s", err))
+		return
+	}
+	r.setForwardIDs(ctx, data, ids)
+
+	// Turn off forwarding to the system nameservers, so the set is used
+	if data.DisableSystemNameserverForwarding.ValueBool() {
+		err = r.client.Unbound().DisableSystemNameserverForwarding(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to disable system nameserver forwarding, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UnboundForwardSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UnboundForwardSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.reconcileForwards(ctx, r.ownedForwardIDs(ctx, data), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete forward set, got error: %s", err))
+		return
+	}
+}
+
+func (r *UnboundForwardSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *UnboundForwardSetResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Forwarding to the system nameservers only takes precedence over forwards without a domain
+	if data.DisableSystemNameserverForwarding.ValueBool() && !data.Domain.IsUnknown() && data.Domain.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("disable_system_nameserver_forwarding"), "Invalid Attribute Combination",
+			"Attribute disable_system_nameserver_forwarding can only be true when domain is empty.")
+	}
+
+	if data.Type.ValueString() != "dot" || data.Servers.IsNull() || data.Servers.IsUnknown() {
+		return
+	}
+
+	var servers []UnboundForwardSetServerModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a common name the certificate of the server cannot be verified
+	for i, server := range servers {
+		if !server.VerifyCN.IsUnknown() && server.VerifyCN.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("servers").AtListIndex(i).AtName("verify_cn"), "Missing Verify CN",
+				"Attribute verify_cn must be set for every server when type is dot.")
+		}
+	}
+}
+
+func (r *UnboundForwardSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *UnboundForwardSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() || plan.Servers.IsUnknown() {
+		return
+	}
+
+	var state *UnboundForwardSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.Servers.IsNull() {
+		return
+	}
+
+	// Servers are updated in place, so each keeps the forward at its position in the prior state
+	var servers, stateServers []UnboundForwardSetServerModel
+	plan.Servers.ElementsAs(ctx, &servers, false)
+	state.Servers.ElementsAs(ctx, &stateServers, false)
+	for i := range servers {
+		if i < len(stateServers) && !stateServers[i].Id.IsNull() {
+			servers[i].Id = stateServers[i].Id
+		} else {
+			servers[i].Id = types.StringUnknown()
+		}
+	}
+
+	planned, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: unboundForwardSetServerTypes}, servers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("servers"), planned)...)
+}
+
+func (r *UnboundForwardSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the domain, optionally followed by the type
+	domain, forwardType, ok := unboundForwardSetImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: domain or domain:dot. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), unboundForwardSetIDFromDomain(domain))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), forwardType)...)
+}
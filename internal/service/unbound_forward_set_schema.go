@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// unboundForwardSetRootID is the ID of the forward set without a domain, which forwards all queries.
+const unboundForwardSetRootID = "."
+
+// UnboundForwardSetResourceModel describes the resource data model.
+type UnboundForwardSetResourceModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Domain  types.String `tfsdk:"domain"`
+	Type    types.String `tfsdk:"type"`
+	Servers types.List   `tfsdk:"servers"`
+
+	DisableSystemNameserverForwarding types.Bool `tfsdk:"disable_system_nameserver_forwarding"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+type UnboundForwardSetServerModel struct {
+	IP       types.String `tfsdk:"ip"`
+	Port     types.Int64  `tfsdk:"port"`
+	VerifyCN types.String `tfsdk:"verify_cn"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+var unboundForwardSetServerTypes = map[string]attr.Type{
+	"ip":        types.StringType,
+	"port":      types.Int64Type,
+	"verify_cn": types.StringType,
+	"id":        types.StringType,
+}
+
+func unboundForwardSetResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure all upstream servers queries for a domain are forwarded to, normally or over TLS. Each server is stored as a separate forward in OPNsense, in the order of `servers`. Only the forwards created by this resource are managed: other forwards for the same domain, such as those of `opnsense_unbound_forward`, are left alone.\n\nA set without a domain is only used while forwarding to the system nameservers is turned off, since that takes precedence. Set `disable_system_nameserver_forwarding` to turn it off when the set is created or updated.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the forwards of this set. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Queries for this domain are forwarded to the servers of this set. Set to `\"\"` to forward all queries.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "How queries are forwarded to the servers. Available values: `forward`, `dot` (DNS-over-TLS). Defaults to `forward`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("forward"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("forward", "dot"),
+				},
+			},
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "The upstream servers of this set, in the order they are configured in Unbound.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "IP address of the DNS server.",
+							Required:            true,
+							Validators: []validator.String{
+								validators.IPAddress(),
+							},
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the DNS server, for usual DNS use `53`, if you use DoT set it to `853`. Defaults to `53`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(53),
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"verify_cn": schema.StringAttribute{
+							MarkdownDescription: "The Common Name of the DNS server (e.g. `dns.example.com`), used to verify its TLS certificate. Must be set when `type` is `dot`. Defaults to `\"\"`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the forward backing this server.",
+							Computed:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"disable_system_nameserver_forwarding": schema.BoolAttribute{
+				MarkdownDescription: "Turn off forwarding to the system nameservers (`forward_to_system_nameservers` of `opnsense_unbound_settings`) when this set is created or updated, so that it is used. Only valid without a domain. Do not combine with `forward_to_system_nameservers = true` in `opnsense_unbound_settings`, as each would keep reverting the other. Destroying this resource does not turn the setting back on. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the forward set, which is its domain, or `.` when forwarding all queries.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// unboundForwardSetImportID splits an import ID into the domain and the type of the forward set.
+func unboundForwardSetImportID(id string) (string, string, bool) {
+	domain, forwardType, found := strings.Cut(id, ":")
+	if !found {
+		forwardType = "forward"
+	}
+	if forwardType != "forward" && forwardType != "dot" {
+		return "", "", false
+	}
+	return unboundForwardSetDomainFromID(domain), forwardType, true
+}
+
+func unboundForwardSetDomainFromID(id string) string {
+	if id == unboundForwardSetRootID {
+		return ""
+	}
+	return id
+}
+
+func unboundForwardSetIDFromDomain(domain string) string {
+	if domain == "" {
+		return unboundForwardSetRootID
+	}
+	return domain
+}
+
+func convertUnboundForwardSetSchemaToStruct(d *UnboundForwardSetResourceModel) ([]*unbound.Forward, error) {
+	var servers []UnboundForwardSetServerModel
+	d.Servers.ElementsAs(context.Background(), &servers, false)
+
+	var forwards []*unbound.Forward
+	for _, server := range servers {
+		forwards = append(forwards, &unbound.Forward{
+			Enabled:  tools.BoolToString(d.Enabled.ValueBool()),
+			Domain:   d.Domain.ValueString(),
+			Type:     api.SelectedMap(d.Type.ValueString()),
+			Server:   server.IP.ValueString(),
+			Port:     tools.Int64ToString(server.Port.ValueInt64()),
+			VerifyCN: server.VerifyCN.ValueString(),
+		})
+	}
+
+	return forwards, nil
+}
+
+func convertUnboundForwardSetStructToSchema(domain string, ids []string, d []*unbound.Forward) (*UnboundForwardSetResourceModel, error) {
+	model := &UnboundForwardSetResourceModel{
+		Enabled: types.BoolValue(true),
+		Domain:  types.StringValue(domain),
+		Type:    types.StringValue("forward"),
+	}
+
+	// The settings shared by all servers are taken from the first forward
+	if len(d) > 0 {
+		model.Enabled = types.BoolValue(tools.StringToBool(d[0].Enabled))
+		model.Type = types.StringValue(d[0].Type.String())
+	}
+
+	servers := []UnboundForwardSetServerModel{}
+	for i, forward := range d {
+		servers = append(servers, UnboundForwardSetServerModel{
+			IP:       types.StringValue(forward.Server),
+			Port:     types.Int64Value(tools.StringToInt64(forward.Port)),
+			VerifyCN: types.StringValue(forward.VerifyCN),
+			Id:       types.StringValue(ids[i]),
+		})
+	}
+
+	model.Servers, _ = types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: unboundForwardSetServerTypes,
+		},
+		servers,
+	)
+
+	return model, nil
+}
@@ -25,7 +25,7 @@ type UnboundHostAliasResourceModel struct {
 
 func unboundHostAliasResourceSchema() schema.Schema {
 	return schema.Schema{
-		MarkdownDescription: "Host aliases can be used to create alternative names for a Host",
+		MarkdownDescription: "Host aliases can be used to create alternative names for a Host. The alias is answered with the records of the associated host override, which makes it behave like a CNAME record.",
 
 		Attributes: map[string]schema.Attribute{
 			"override": schema.StringAttribute{
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -34,16 +36,47 @@ func (d *UnboundHostOverrideDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = opnsense.NewClient(apiClient)
+	d.client = client
+}
+
+// findByName returns the UUID of the only host override for the hostname and domain, optionally restricted to a
+// record type. DNS names are case-insensitive, so are the comparisons.
+func (d *UnboundHostOverrideDataSource) findByName(ctx context.Context, hostname string, domain string, rrType string) (string, error) {
+	overrides, err := d.client.Unbound().GetHostOverrideAll(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for id, override := range overrides {
+		if !strings.EqualFold(override.Hostname, hostname) || !strings.EqualFold(override.Domain, domain) {
+			continue
+		}
+		if rrType != "" && override.Type.String() != rrType {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no host override found for %s.%s", hostname, domain)
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Strings(ids)
+		return "", fmt.Errorf("%d host overrides found for %s.%s, set type to select one (%s)",
+			len(ids), hostname, domain, strings.Join(ids, ", "))
+	}
 }
 
 func (d *UnboundHostOverrideDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -56,6 +89,17 @@ func (d *UnboundHostOverrideDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	// Look up the UUID by name when no ID is set
+	if data.Id.IsNull() {
+		id, err := d.findByName(ctx, data.Hostname.ValueString(), data.Domain.ValueString(), data.Type.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read host_override, got error: %s", err))
+			return
+		}
+		data.Id = types.StringValue(id)
+	}
+
 	// Get resource from OPNsense API
 	resource, err := d.client.Unbound().GetHostOverride(ctx, data.Id.ValueString())
 	if err != nil {
@@ -4,18 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/netip"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UnboundHostOverrideResource{}
 var _ resource.ResourceWithImportState = &UnboundHostOverrideResource{}
+var _ resource.ResourceWithValidateConfig = &UnboundHostOverrideResource{}
 
 func NewUnboundHostOverrideResource() resource.Resource {
 	return &UnboundHostOverrideResource{}
@@ -40,16 +41,16 @@ func (r *UnboundHostOverrideResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*api.Client)
+	client, ok := req.ProviderData.(opnsense.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = opnsense.NewClient(apiClient)
+	r.client = client
 }
 
 func (r *UnboundHostOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -177,6 +178,68 @@ func (r *UnboundHostOverrideResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+func (r *UnboundHostOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *UnboundHostOverrideResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	// An unset type defaults to an A record
+	rrType := data.Type.ValueString()
+	if data.Type.IsNull() {
+		rrType = "A"
+	}
+
+	// Each record type only uses its own attributes, the others are ignored by OPNsense
+	switch rrType {
+	case "A", "AAAA":
+		if !data.Server.IsUnknown() {
+			addr, err := netip.ParseAddr(data.Server.ValueString())
+			if err != nil || (rrType == "A" && !addr.Is4()) || (rrType == "AAAA" && !addr.Is6()) {
+				version := "IPv4"
+				if rrType == "AAAA" {
+					version = "IPv6"
+				}
+				resp.Diagnostics.AddAttributeError(path.Root("server"), "Invalid Server",
+					fmt.Sprintf("Attribute server must be an %s address when type is %s, got: %q.", version, rrType, data.Server.ValueString()))
+			}
+		}
+	case "MX":
+		if data.MXPriority.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mx_priority"), "Missing MX Priority",
+				"Attribute mx_priority must be set when type is MX.")
+		}
+		if data.MXDomain.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mx_host"), "Missing MX Host",
+				"Attribute mx_host must be set when type is MX.")
+		}
+	case "TXT":
+		if !data.TXTData.IsUnknown() && data.TXTData.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("txt_data"), "Missing TXT Data",
+				"Attribute txt_data must be set when type is TXT.")
+		}
+	}
+
+	if rrType != "MX" {
+		if !data.MXPriority.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mx_priority"), "Invalid MX Priority",
+				fmt.Sprintf("Attribute mx_priority can only be set when type is MX, got type: %s.", rrType))
+		}
+		if !data.MXDomain.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mx_host"), "Invalid MX Host",
+				fmt.Sprintf("Attribute mx_host can only be set when type is MX, got type: %s.", rrType))
+		}
+	}
+	if rrType != "TXT" && !data.TXTData.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("txt_data"), "Invalid TXT Data",
+			fmt.Sprintf("Attribute txt_data can only be set when type is TXT, got type: %s.", rrType))
+	}
+}
+
 func (r *UnboundHostOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
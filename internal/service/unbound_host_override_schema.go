@@ -2,7 +2,7 @@ package service
 
 import (
 	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/unbound"
+	lib "github.com/browningluke/opnsense-go/pkg/unbound"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
 	"terraform-provider-opnsense/internal/tools"
 )
 
@@ -30,12 +31,14 @@ type UnboundHostOverrideResourceModel struct {
 	MXPriority types.Int64  `tfsdk:"mx_priority"`
 	MXDomain   types.String `tfsdk:"mx_host"`
 
+	TXTData types.String `tfsdk:"txt_data"`
+
 	Id types.String `tfsdk:"id"`
 }
 
 func unboundHostOverrideResourceSchema() schema.Schema {
 	return schema.Schema{
-		MarkdownDescription: "Host overrides can be used to change DNS results from client queries or to add custom DNS records.",
+		MarkdownDescription: "Host overrides can be used to change DNS results from client queries or to add custom DNS records. To point additional names at a host override, similar to a CNAME record, use `opnsense_unbound_host_alias`.",
 
 		Attributes: map[string]schema.Attribute{
 			"enabled": schema.BoolAttribute{
@@ -53,22 +56,22 @@ func unboundHostOverrideResourceSchema() schema.Schema {
 				Required:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`. Defaults to `A`.",
+				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`. Defaults to `A`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("A"),
 				Validators: []validator.String{
-					stringvalidator.OneOf("A", "AAAA", "MX"),
+					stringvalidator.OneOf("A", "AAAA", "MX", "TXT"),
 				},
 			},
 			"server": schema.StringAttribute{
-				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1. Must be set when `type` is `A` or `AAAA`.",
+				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1. Must be set to an IPv4 address when `type` is `A` and to an IPv6 address when `type` is `AAAA`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
 			"mx_priority": schema.Int64Attribute{
-				MarkdownDescription: "Priority of MX record, e.g. 10. Must be set when `type` is `MX`.",
+				MarkdownDescription: "Priority of MX record, e.g. 10. Must be set when `type` is `MX`, and only then.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
@@ -79,7 +82,7 @@ func unboundHostOverrideResourceSchema() schema.Schema {
 				},
 			},
 			"mx_host": schema.StringAttribute{
-				MarkdownDescription: "Host name of MX host, e.g. mail.example.com. Must be set when `type` is `MX`.",
+				MarkdownDescription: "Host name of MX host, e.g. mail.example.com. Must be set when `type` is `MX`, and only then.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
@@ -89,6 +92,12 @@ func unboundHostOverrideResourceSchema() schema.Schema {
 					}...),
 				},
 			},
+			"txt_data": schema.StringAttribute{
+				MarkdownDescription: "Content of the TXT record, e.g. `v=spf1 mx -all`. Must be set when `type` is `TXT`, and only then.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Optional:            true,
@@ -106,12 +115,16 @@ func unboundHostOverrideResourceSchema() schema.Schema {
 
 func UnboundHostOverrideDataSourceSchema() dschema.Schema {
 	return dschema.Schema{
-		MarkdownDescription: "Host overrides can be used to change DNS results from client queries or to add custom DNS records.",
+		MarkdownDescription: "Host overrides can be used to change DNS results from client queries or to add custom DNS records. Look up a host override either by `id`, or by `hostname` and `domain`.",
 
 		Attributes: map[string]dschema.Attribute{
 			"id": dschema.StringAttribute{
-				MarkdownDescription: "UUID of the resource.",
-				Required:            true,
+				MarkdownDescription: "UUID of the resource. Must be set unless `hostname` and `domain` are set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("hostname")),
+				},
 			},
 			"enabled": dschema.BoolAttribute{
 				MarkdownDescription: "Whether this route is enabled.",
@@ -122,16 +135,29 @@ func UnboundHostOverrideDataSourceSchema() dschema.Schema {
 				Computed:            true,
 			},
 			"hostname": dschema.StringAttribute{
-				MarkdownDescription: "Name of the host, without the domain part. Use `*` to create a wildcard entry.",
+				MarkdownDescription: "Name of the host, without the domain part. Set together with `domain` to look up the host override by name.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("domain")),
+				},
 			},
 			"domain": dschema.StringAttribute{
-				MarkdownDescription: "Domain of the host, e.g. example.com",
+				MarkdownDescription: "Domain of the host, e.g. example.com. Set together with `hostname` to look up the host override by name.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("hostname")),
+				},
 			},
 			"type": dschema.StringAttribute{
-				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`.",
+				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`. Can be set when looking up by name, to pick one of several records for the same host.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "MX", "TXT"),
+					stringvalidator.AlsoRequires(path.MatchRoot("hostname")),
+				},
 			},
 			"server": dschema.StringAttribute{
 				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1.",
@@ -145,20 +171,27 @@ func UnboundHostOverrideDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Host name of MX host, e.g. mail.example.com.",
 				Computed:            true,
 			},
+			"txt_data": dschema.StringAttribute{
+				MarkdownDescription: "Content of the TXT record.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
 func convertUnboundHostOverrideSchemaToStruct(d *UnboundHostOverrideResourceModel) (*unbound.HostOverride, error) {
 	return &unbound.HostOverride{
-		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
-		Hostname:    d.Hostname.ValueString(),
-		Domain:      d.Domain.ValueString(),
-		Type:        api.SelectedMap(d.Type.ValueString()),
-		Server:      d.Server.ValueString(),
-		MXDomain:    d.MXDomain.ValueString(),
-		MXPriority:  tools.Int64ToStringNegative(d.MXPriority.ValueInt64()),
-		Description: d.Description.ValueString(),
+		HostOverride: lib.HostOverride{
+			Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+			Hostname:    d.Hostname.ValueString(),
+			Domain:      d.Domain.ValueString(),
+			Type:        api.SelectedMap(d.Type.ValueString()),
+			Server:      d.Server.ValueString(),
+			MXDomain:    d.MXDomain.ValueString(),
+			MXPriority:  tools.Int64ToStringNegative(d.MXPriority.ValueInt64()),
+			Description: d.Description.ValueString(),
+		},
+		TXTData: d.TXTData.ValueString(),
 	}, nil
 }
 
@@ -171,6 +204,7 @@ func convertUnboundHostOverrideStructToSchema(d *unbound.HostOverride) (*Unbound
 		Server:      types.StringValue(d.Server),
 		MXPriority:  types.Int64Value(tools.StringToInt64(d.MXPriority)),
 		MXDomain:    types.StringValue(d.MXDomain),
+		TXTData:     types.StringValue(d.TXTData),
 		Description: tools.StringOrNull(d.Description),
 	}, nil
 }
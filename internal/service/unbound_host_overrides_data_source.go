@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnboundHostOverridesDataSource{}
+
+func NewUnboundHostOverridesDataSource() datasource.DataSource {
+	return &UnboundHostOverridesDataSource{}
+}
+
+// UnboundHostOverridesDataSource defines the data source implementation.
+type UnboundHostOverridesDataSource struct {
+	client opnsense.Client
+}
+
+func (d *UnboundHostOverridesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_host_overrides"
+}
+
+func (d *UnboundHostOverridesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = UnboundHostOverridesDataSourceSchema()
+}
+
+func (d *UnboundHostOverridesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UnboundHostOverridesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UnboundHostOverridesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate the description filter before reading any host overrides
+	var descriptionRegex *regexp.Regexp
+	if !data.DescriptionRegex.IsNull() {
+		var err error
+		descriptionRegex, err = regexp.Compile(data.DescriptionRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("description_regex"), "Invalid Description Regex",
+				fmt.Sprintf("Attribute description_regex must be a valid regular expression, got error: %s", err))
+			return
+		}
+	}
+
+	// Get resources from OPNsense API
+	resources, err := d.client.Unbound().GetHostOverrideAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	model, err := convertUnboundHostOverridesStructToSchema(resources, data, descriptionRegex)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+)
+
+type UnboundHostOverridesDataSourceModel struct {
+	Domain           types.String `tfsdk:"domain"`
+	Type             types.String `tfsdk:"type"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	DescriptionRegex types.String `tfsdk:"description_regex"`
+	HostOverrides    types.List   `tfsdk:"host_overrides"`
+}
+
+var unboundHostOverrideAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"enabled":     types.BoolType,
+	"hostname":    types.StringType,
+	"domain":      types.StringType,
+	"type":        types.StringType,
+	"server":      types.StringType,
+	"mx_priority": types.Int64Type,
+	"mx_host":     types.StringType,
+	"txt_data":    types.StringType,
+	"description": types.StringType,
+}
+
+func UnboundHostOverridesDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Get all host overrides, optionally filtered, e.g. to reconcile the records of an IPAM against the records served by the firewall.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Only return host overrides of this domain, compared case-insensitively. Returns all domains when not set.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return host overrides of this record type. Available values: `A`, `AAAA`, `MX`, `TXT`. Returns all types when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "MX", "TXT"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only return enabled (`true`) or disabled (`false`) host overrides. Returns both when not set.",
+				Optional:            true,
+			},
+			"description_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return host overrides whose description matches this regular expression (RE2 syntax). Returns all host overrides when not set.",
+				Optional:            true,
+			},
+			"host_overrides": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all matching host overrides, ordered by domain, hostname and type.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the host override.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether this host override is enabled.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Name of the host, without the domain part.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain of the host, e.g. example.com.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of resource record, `A`, `AAAA`, `MX` or `TXT`.",
+							Computed:            true,
+						},
+						"server": schema.StringAttribute{
+							MarkdownDescription: "IP address of the host.",
+							Computed:            true,
+						},
+						"mx_priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority of MX record, `-1` if not an MX record.",
+							Computed:            true,
+						},
+						"mx_host": schema.StringAttribute{
+							MarkdownDescription: "Host name of MX host.",
+							Computed:            true,
+						},
+						"txt_data": schema.StringAttribute{
+							MarkdownDescription: "Content of the TXT record.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Optional description here for your reference (not parsed).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// unboundHostOverrideMatches reports whether a host override passes all filters which are set.
+func unboundHostOverrideMatches(d *unbound.HostOverride, filters *UnboundHostOverridesDataSourceModel, descriptionRegex *regexp.Regexp) bool {
+	if !filters.Domain.IsNull() && !strings.EqualFold(d.Domain, filters.Domain.ValueString()) {
+		return false
+	}
+	if !filters.Type.IsNull() && d.Type.String() != filters.Type.ValueString() {
+		return false
+	}
+	if !filters.Enabled.IsNull() && (d.Enabled == "1") != filters.Enabled.ValueBool() {
+		return false
+	}
+	if descriptionRegex != nil && !descriptionRegex.MatchString(d.Description) {
+		return false
+	}
+	return true
+}
+
+func convertUnboundHostOverridesStructToSchema(d map[string]*unbound.HostOverride, filters *UnboundHostOverridesDataSourceModel, descriptionRegex *regexp.Regexp) (*UnboundHostOverridesDataSourceModel, error) {
+	model := &UnboundHostOverridesDataSourceModel{
+		Domain:           filters.Domain,
+		Type:             filters.Type,
+		Enabled:          filters.Enabled,
+		DescriptionRegex: filters.DescriptionRegex,
+	}
+
+	overrides := []UnboundHostOverrideResourceModel{}
+	for id, override := range d {
+		if !unboundHostOverrideMatches(override, filters, descriptionRegex) {
+			continue
+		}
+
+		overrideModel, err := convertUnboundHostOverrideStructToSchema(override)
+		if err != nil {
+			return nil, err
+		}
+		overrideModel.Id = types.StringValue(id)
+		overrides = append(overrides, *overrideModel)
+	}
+
+	// Sort for a stable order, the host overrides are returned as a map
+	sort.Slice(overrides, func(i, j int) bool {
+		a, b := overrides[i], overrides[j]
+		if a.Domain.ValueString() != b.Domain.ValueString() {
+			return a.Domain.ValueString() < b.Domain.ValueString()
+		}
+		if a.Hostname.ValueString() != b.Hostname.ValueString() {
+			return a.Hostname.ValueString() < b.Hostname.ValueString()
+		}
+		if a.Type.ValueString() != b.Type.ValueString() {
+			return a.Type.ValueString() < b.Type.ValueString()
+		}
+		return a.Id.ValueString() < b.Id.ValueString()
+	})
+
+	model.HostOverrides, _ = types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: unboundHostOverrideAttrTypes,
+		},
+		overrides,
+	)
+
+	return model, nil
+}
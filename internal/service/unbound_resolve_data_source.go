@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnboundResolveDataSource{}
+
+func NewUnboundResolveDataSource() datasource.DataSource {
+	return &UnboundResolveDataSource{}
+}
+
+// UnboundResolveDataSource defines the data source implementation.
+type UnboundResolveDataSource struct {
+	client opnsense.Client
+}
+
+func (d *UnboundResolveDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_resolve"
+}
+
+func (d *UnboundResolveDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = UnboundResolveDataSourceSchema()
+}
+
+func (d *UnboundResolveDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UnboundResolveDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UnboundResolveDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Queries go to the resolver of the firewall unless another server is set
+	server := unboundResolveDefaultServer
+	if !data.Server.IsNull() {
+		server = data.Server.ValueString()
+	}
+
+	// Get resources from OPNsense API
+	resources, err := d.client.Unbound().LookupDNS(ctx, data.Hostname.ValueString(), server)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to resolve %s, got error: %s", data.Hostname.ValueString(), err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	model, err := convertUnboundResolveStructToSchema(data.Hostname.ValueString(), server, resources)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to resolve %s, got error: %s", data.Hostname.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
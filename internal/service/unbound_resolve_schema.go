@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// unboundResolveDefaultServer is the resolver running on the firewall itself.
+const unboundResolveDefaultServer = "127.0.0.1"
+
+type UnboundResolveDataSourceModel struct {
+	Hostname  types.String `tfsdk:"hostname"`
+	Server    types.String `tfsdk:"server"`
+	Records   types.List   `tfsdk:"records"`
+	Addresses types.List   `tfsdk:"addresses"`
+}
+
+type UnboundResolveRecordModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+var unboundResolveRecordAttrTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"value": types.StringType,
+}
+
+func UnboundResolveDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Resolve a name through the resolver of the firewall using the DNS lookup diagnostics, e.g. to verify in a `check` block that a host override resolves as intended.",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified name to resolve, e.g. `host.example.com`.",
+				Required:            true,
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "IP address of the DNS server to query. Defaults to `127.0.0.1`, the Unbound resolver of the firewall.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of all answers, ordered by type and value.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the record, e.g. `A`, `AAAA`, `MX` or `TXT`.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the record.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"addresses": schema.ListAttribute{
+				MarkdownDescription: "The values of all `A` and `AAAA` answers, ordered by value.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func convertUnboundResolveStructToSchema(hostname string, server string, d unbound.DNSLookup) (*UnboundResolveDataSourceModel, error) {
+	model := &UnboundResolveDataSourceModel{
+		Hostname: types.StringValue(hostname),
+		Server:   types.StringValue(server),
+	}
+
+	records := []UnboundResolveRecordModel{}
+	addresses := []string{}
+	for rrType, values := range d {
+		for _, value := range values {
+			records = append(records, UnboundResolveRecordModel{
+				Type:  types.StringValue(rrType),
+				Value: types.StringValue(value),
+			})
+			if rrType == "A" || rrType == "AAAA" {
+				addresses = append(addresses, value)
+			}
+		}
+	}
+
+	// Sort for a stable order, the answers are returned as a map
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Type.ValueString() != records[j].Type.ValueString() {
+			return records[i].Type.ValueString() < records[j].Type.ValueString()
+		}
+		return records[i].Value.ValueString() < records[j].Value.ValueString()
+	})
+	sort.Strings(addresses)
+
+	model.Records, _ = types.ListValueFrom(
+		context.Background(),
+		types.ObjectType{
+			AttrTypes: unboundResolveRecordAttrTypes,
+		},
+		records,
+	)
+	model.Addresses, _ = types.ListValueFrom(context.Background(), types.StringType, addresses)
+
+	return model, nil
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// unboundSettingsId is the ID of the Unbound settings singleton resource.
+const unboundSettingsId = "settings"
+
+// UnboundSettingsResourceModel describes the resource data model.
+type UnboundSettingsResourceModel struct {
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	Port                       types.Int64  `tfsdk:"port"`
+	Interfaces                 types.Set    `tfsdk:"interfaces"`
+	OutgoingInterfaces         types.Set    `tfsdk:"outgoing_interfaces"`
+	DNSSEC                     types.Bool   `tfsdk:"dnssec"`
+	DNS64                      types.Bool   `tfsdk:"dns64"`
+	DNS64Prefix                types.String `tfsdk:"dns64_prefix"`
+	RegisterDHCPLeases         types.Bool   `tfsdk:"register_dhcp_leases"`
+	RegisterDHCPStaticMappings types.Bool   `tfsdk:"register_dhcp_static_mappings"`
+	LocalZoneType              types.String `tfsdk:"local_zone_type"`
+	MessageCacheSize           types.String `tfsdk:"message_cache_size"`
+	RRSetCacheSize             types.String `tfsdk:"rrset_cache_size"`
+	Prefetch                   types.Bool   `tfsdk:"prefetch"`
+	ServeExpired               types.Bool   `tfsdk:"serve_expired"`
+	ForwardToSystemNameservers types.Bool   `tfsdk:"forward_to_system_nameservers"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// unboundLocalZoneTypes are the accepted types of the default local zone.
+var unboundLocalZoneTypes = []string{
+	"transparent", "always_nxdomain", "always_refuse", "always_transparent", "deny",
+	"inform", "inform_deny", "nodefault", "refuse", "static", "typetransparent",
+}
+
+// unboundCacheSizeValidators accept sizes in bytes, with an optional `k`, `m` or `g` suffix.
+var unboundCacheSizeValidators = []validator.String{
+	stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+[kmg]?)?$`),
+		"must be a size in bytes, with an optional k, m or g suffix (e.g. 4m)"),
+}
+
+func unboundSettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Configure the general settings of the Unbound DNS resolver. This is a singleton, only one instance should exist. Destroying it resets the settings to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable Unbound. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The TCP/UDP port used for responding to DNS queries. Defaults to `53`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(53),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces to listen on for DNS queries. This uses identifiers like `lan` or `opt2`. Listens on all interfaces when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"outgoing_interfaces": schema.SetAttribute{
+				MarkdownDescription: "Interfaces to send queries to authoritative servers from. This uses identifiers like `wan` or `opt2`. Uses all interfaces when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue(types.StringType)),
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Enable DNSSEC validation. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dns64": schema.BoolAttribute{
+				MarkdownDescription: "Enable DNS64, synthesising AAAA records for hosts that only have A records. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dns64_prefix": schema.StringAttribute{
+				MarkdownDescription: "The IPv6 prefix used for DNS64 (e.g. `64:ff9b::/96`). Uses `64:ff9b::/96` when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					validators.IPv6CIDR(),
+				},
+			},
+			"register_dhcp_leases": schema.BoolAttribute{
+				MarkdownDescription: "Register the hostnames of DHCP clients, so they can be resolved. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"register_dhcp_static_mappings": schema.BoolAttribute{
+				MarkdownDescription: "Register DHCP static mappings, so their hostnames can be resolved. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"local_zone_type": schema.StringAttribute{
+				MarkdownDescription: "How queries that do not match a local host or domain override are answered. One of `transparent`, `always_nxdomain`, `always_refuse`, `always_transparent`, `deny`, `inform`, `inform_deny`, `nodefault`, `refuse`, `static` or `typetransparent`. Defaults to `\"transparent\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("transparent"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(unboundLocalZoneTypes...),
+				},
+			},
+			"message_cache_size": schema.StringAttribute{
+				MarkdownDescription: "Size of the message cache, in bytes with an optional `k`, `m` or `g` suffix (e.g. `4m`). Uses the Unbound default when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators:          unboundCacheSizeValidators,
+			},
+			"rrset_cache_size": schema.StringAttribute{
+				MarkdownDescription: "Size of the RRset cache, in bytes with an optional `k`, `m` or `g` suffix (e.g. `8m`). Uses the Unbound default when empty. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators:          unboundCacheSizeValidators,
+			},
+			"prefetch": schema.BoolAttribute{
+				MarkdownDescription: "Refresh popular cache entries before they expire. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"serve_expired": schema.BoolAttribute{
+				MarkdownDescription: "Answer from expired cache entries while they are being refreshed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"forward_to_system_nameservers": schema.BoolAttribute{
+				MarkdownDescription: "Forward queries to the nameservers configured in the system settings instead of resolving them recursively. Queries for domains with an `opnsense_unbound_forward` are always forwarded. Takes precedence over forwards and forward sets without a domain, so set this to `false` when using those. Their `disable_system_nameserver_forwarding` also turns this off, do not combine it with `true` here. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the Unbound settings, always `settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func UnboundSettingsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Configure the general settings of the Unbound DNS resolver.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "ID of the Unbound settings, always `settings`.",
+				Computed:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether Unbound is enabled.",
+				Computed:            true,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "The TCP/UDP port used for responding to DNS queries.",
+				Computed:            true,
+			},
+			"interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces to listen on for DNS queries, all interfaces when empty.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"outgoing_interfaces": dschema.SetAttribute{
+				MarkdownDescription: "Interfaces to send queries to authoritative servers from, all interfaces when empty.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dnssec": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DNSSEC validation is enabled.",
+				Computed:            true,
+			},
+			"dns64": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DNS64 is enabled.",
+				Computed:            true,
+			},
+			"dns64_prefix": dschema.StringAttribute{
+				MarkdownDescription: "The IPv6 prefix used for DNS64.",
+				Computed:            true,
+			},
+			"register_dhcp_leases": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the hostnames of DHCP clients are registered.",
+				Computed:            true,
+			},
+			"register_dhcp_static_mappings": dschema.BoolAttribute{
+				MarkdownDescription: "Whether DHCP static mappings are registered.",
+				Computed:            true,
+			},
+			"local_zone_type": dschema.StringAttribute{
+				MarkdownDescription: "How queries that do not match a local host or domain override are answered.",
+				Computed:            true,
+			},
+			"message_cache_size": dschema.StringAttribute{
+				MarkdownDescription: "Size of the message cache, empty if the Unbound default is used.",
+				Computed:            true,
+			},
+			"rrset_cache_size": dschema.StringAttribute{
+				MarkdownDescription: "Size of the RRset cache, empty if the Unbound default is used.",
+				Computed:            true,
+			},
+			"prefetch": dschema.BoolAttribute{
+				MarkdownDescription: "Whether popular cache entries are refreshed before they expire.",
+				Computed:            true,
+			},
+			"serve_expired": dschema.BoolAttribute{
+				MarkdownDescription: "Whether expired cache entries are served while they are being refreshed.",
+				Computed:            true,
+			},
+			"forward_to_system_nameservers": dschema.BoolAttribute{
+				MarkdownDescription: "Whether queries are forwarded to the system nameservers.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// unboundSettingsDefaults returns the Unbound settings of a fresh OPNsense install, used to reset the singleton.
+func unboundSettingsDefaults() *unbound.Settings {
+	return &unbound.Settings{
+		General: unbound.SettingsGeneral{
+			Enabled:            "1",
+			Port:               "53",
+			ActiveInterface:    api.SelectedMapList{},
+			OutgoingInterface:  api.SelectedMapList{},
+			DNSSEC:             "0",
+			DNS64:              "0",
+			DNS64Prefix:        "",
+			RegisterDHCP:       "0",
+			RegisterDHCPStatic: "0",
+			LocalZoneType:      "transparent",
+		},
+		Advanced: unbound.SettingsAdvanced{
+			Prefetch:         "0",
+			ServeExpired:     "0",
+			MessageCacheSize: "",
+			RRSetCacheSize:   "",
+		},
+		Forwarding: unbound.SettingsForwarding{
+			Enabled: "0",
+		},
+	}
+}
+
+func convertUnboundSettingsSchemaToStruct(d *UnboundSettingsResourceModel) (*unbound.Settings, error) {
+	return &unbound.Settings{
+		General: unbound.SettingsGeneral{
+			Enabled:            tools.BoolToString(d.Enabled.ValueBool()),
+			Port:               tools.Int64ToString(d.Port.ValueInt64()),
+			ActiveInterface:    tools.SetToStringSlice(d.Interfaces),
+			OutgoingInterface:  tools.SetToStringSlice(d.OutgoingInterfaces),
+			DNSSEC:             tools.BoolToString(d.DNSSEC.ValueBool()),
+			DNS64:              tools.BoolToString(d.DNS64.ValueBool()),
+			DNS64Prefix:        d.DNS64Prefix.ValueString(),
+			RegisterDHCP:       tools.BoolToString(d.RegisterDHCPLeases.ValueBool()),
+			RegisterDHCPStatic: tools.BoolToString(d.RegisterDHCPStaticMappings.ValueBool()),
+			LocalZoneType:      api.SelectedMap(d.LocalZoneType.ValueString()),
+		},
+		Advanced: unbound.SettingsAdvanced{
+			Prefetch:         tools.BoolToString(d.Prefetch.ValueBool()),
+			ServeExpired:     tools.BoolToString(d.ServeExpired.ValueBool()),
+			MessageCacheSize: d.MessageCacheSize.ValueString(),
+			RRSetCacheSize:   d.RRSetCacheSize.ValueString(),
+		},
+		Forwarding: unbound.SettingsForwarding{
+			Enabled: tools.BoolToString(d.ForwardToSystemNameservers.ValueBool()),
+		},
+	}, nil
+}
+
+func convertUnboundSettingsStructToSchema(d *unbound.Settings) (*UnboundSettingsResourceModel, error) {
+	return &UnboundSettingsResourceModel{
+		Enabled:                    types.BoolValue(tools.StringToBool(d.General.Enabled)),
+		Port:                       types.Int64Value(tools.StringToInt64(d.General.Port)),
+		Interfaces:                 tools.StringSliceToSet(d.General.ActiveInterface),
+		OutgoingInterfaces:         tools.StringSliceToSet(d.General.OutgoingInterface),
+		DNSSEC:                     types.BoolValue(tools.StringToBool(d.General.DNSSEC)),
+		DNS64:                      types.BoolValue(tools.StringToBool(d.General.DNS64)),
+		DNS64Prefix:                types.StringValue(d.General.DNS64Prefix),
+		RegisterDHCPLeases:         types.BoolValue(tools.StringToBool(d.General.RegisterDHCP)),
+		RegisterDHCPStaticMappings: types.BoolValue(tools.StringToBool(d.General.RegisterDHCPStatic)),
+		LocalZoneType:              types.StringValue(d.General.LocalZoneType.String()),
+		MessageCacheSize:           types.StringValue(d.Advanced.MessageCacheSize),
+		RRSetCacheSize:             types.StringValue(d.Advanced.RRSetCacheSize),
+		Prefetch:                   types.BoolValue(tools.StringToBool(d.Advanced.Prefetch)),
+		ServeExpired:               types.BoolValue(tools.StringToBool(d.Advanced.ServeExpired)),
+		ForwardToSystemNameservers: types.BoolValue(tools.StringToBool(d.Forwarding.Enabled)),
+	}, nil
+}
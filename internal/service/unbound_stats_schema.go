@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type UnboundStatsDataSourceModel struct {
+	Uptime              types.Float64 `tfsdk:"uptime"`
+	Queries             types.Int64   `tfsdk:"queries"`
+	CacheHits           types.Int64   `tfsdk:"cache_hits"`
+	CacheMisses         types.Int64   `tfsdk:"cache_misses"`
+	Prefetches          types.Int64   `tfsdk:"prefetches"`
+	RecursiveReplies    types.Int64   `tfsdk:"recursive_replies"`
+	RecursionTimeAvg    types.Float64 `tfsdk:"recursion_time_avg"`
+	RecursionTimeMedian types.Float64 `tfsdk:"recursion_time_median"`
+	QueryTypes          types.Map     `tfsdk:"query_types"`
+	AnswerCodes         types.Map     `tfsdk:"answer_codes"`
+}
+
+func UnboundStatsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The Unbound statistics can be used to get the runtime counters of the resolver since it was last started, e.g. to verify in a `check` block that queries are answered from the cache.",
+
+		Attributes: map[string]schema.Attribute{
+			"uptime": schema.Float64Attribute{
+				MarkdownDescription: "Time in seconds since Unbound was started.",
+				Computed:            true,
+			},
+			"queries": schema.Int64Attribute{
+				MarkdownDescription: "Total number of queries received.",
+				Computed:            true,
+			},
+			"cache_hits": schema.Int64Attribute{
+				MarkdownDescription: "Number of queries answered from the cache.",
+				Computed:            true,
+			},
+			"cache_misses": schema.Int64Attribute{
+				MarkdownDescription: "Number of queries which needed recursive processing.",
+				Computed:            true,
+			},
+			"prefetches": schema.Int64Attribute{
+				MarkdownDescription: "Number of cache prefetches performed.",
+				Computed:            true,
+			},
+			"recursive_replies": schema.Int64Attribute{
+				MarkdownDescription: "Number of replies sent to queries which needed recursive processing.",
+				Computed:            true,
+			},
+			"recursion_time_avg": schema.Float64Attribute{
+				MarkdownDescription: "Average time in seconds it took to answer queries which needed recursive processing.",
+				Computed:            true,
+			},
+			"recursion_time_median": schema.Float64Attribute{
+				MarkdownDescription: "Median time in seconds it took to answer queries which needed recursive processing.",
+				Computed:            true,
+			},
+			"query_types": schema.MapAttribute{
+				MarkdownDescription: "Number of queries received per record type, e.g. `A` or `AAAA`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"answer_codes": schema.MapAttribute{
+				MarkdownDescription: "Number of answers sent per return code, e.g. `NOERROR` or `NXDOMAIN`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func convertUnboundStatsCounters(values map[string]string) map[string]int64 {
+	counters := map[string]int64{}
+	for name, value := range values {
+		counters[name] = tools.StringToInt64(value)
+	}
+	return counters
+}
+
+func convertUnboundStatsStructToSchema(d unbound.Stats) (*UnboundStatsDataSourceModel, error) {
+	model := &UnboundStatsDataSourceModel{
+		Uptime:              tools.StringToFloat64Null(d.Value("time.up")),
+		Queries:             tools.StringToInt64Null(d.Value("total.num.queries")),
+		CacheHits:           tools.StringToInt64Null(d.Value("total.num.cachehits")),
+		CacheMisses:         tools.StringToInt64Null(d.Value("total.num.cachemiss")),
+		Prefetches:          tools.StringToInt64Null(d.Value("total.num.prefetch")),
+		RecursiveReplies:    tools.StringToInt64Null(d.Value("total.num.recursivereplies")),
+		RecursionTimeAvg:    tools.StringToFloat64Null(d.Value("total.recursion.time.avg")),
+		RecursionTimeMedian: tools.StringToFloat64Null(d.Value("total.recursion.time.median")),
+	}
+
+	model.QueryTypes, _ = types.MapValueFrom(context.Background(), types.Int64Type,
+		convertUnboundStatsCounters(d.Prefixed("num.query.type")))
+	model.AnswerCodes, _ = types.MapValueFrom(context.Background(), types.Int64Type,
+		convertUnboundStatsCounters(d.Prefixed("num.answer.rcode")))
+
+	return model, nil
+}
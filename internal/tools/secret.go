@@ -0,0 +1,24 @@
+package tools
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HashSecret returns the hex encoded SHA-256 hash of a secret, or an empty string when no secret is set.
+func HashSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// SecretHashValue returns the hash of a configured write-only secret, which is unknown while the secret is.
+func SecretHashValue(secret types.String) types.String {
+	if secret.IsUnknown() {
+		return types.StringUnknown()
+	}
+	return types.StringValue(HashSecret(secret.ValueString()))
+}
@@ -62,6 +62,14 @@ func StringToFloat64(s string) float64 {
 	return -1
 }
 
+func StringToFloat64Null(s string) types.Float64 {
+	i, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return types.Float64Value(i)
+	}
+	return types.Float64Null()
+}
+
 // Bools
 
 func BoolToString(b bool) string {
@@ -118,3 +126,14 @@ func SetToStringSlice(set types.Set) []string {
 	set.ElementsAs(context.Background(), &list, false)
 	return list
 }
+
+// Values
+
+// IsFullyKnown reports whether a value and all values nested in it are known.
+func IsFullyKnown(ctx context.Context, v attr.Value) bool {
+	tv, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		return false
+	}
+	return tv.IsFullyKnown()
+}
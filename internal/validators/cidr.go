@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that a string is a network in CIDR notation of the given version.
+type cidrValidator struct {
+	ipv4 bool
+	ipv6 bool
+}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	switch {
+	case v.ipv4 && v.ipv6:
+		return "value must be an IPv4 or IPv6 network in CIDR notation"
+	case v.ipv4:
+		return "value must be an IPv4 network in CIDR notation"
+	default:
+		return "value must be an IPv6 network in CIDR notation"
+	}
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Empty strings are used by OPNsense for unset values
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString())
+	if err == nil && ((v.ipv4 && prefix.Addr().Is4()) || (v.ipv6 && prefix.Addr().Is6())) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid CIDR",
+		fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
+
+// IPv4CIDR returns a validator which ensures that a configured string is an IPv4 network in CIDR notation.
+// Empty strings are accepted.
+func IPv4CIDR() validator.String {
+	return cidrValidator{ipv4: true}
+}
+
+// IPv6CIDR returns a validator which ensures that a configured string is an IPv6 network in CIDR notation.
+// Empty strings are accepted.
+func IPv6CIDR() validator.String {
+	return cidrValidator{ipv6: true}
+}
+
+// CIDR returns a validator which ensures that a configured string is an IPv4 or IPv6 network in CIDR notation.
+// Empty strings are accepted.
+func CIDR() validator.String {
+	return cidrValidator{ipv4: true, ipv6: true}
+}
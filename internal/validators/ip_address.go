@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator validates that a string is an IP address of the given version.
+type ipAddressValidator struct {
+	ipv4 bool
+	ipv6 bool
+}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	switch {
+	case v.ipv4 && v.ipv6:
+		return "value must be an IPv4 or IPv6 address"
+	case v.ipv4:
+		return "value must be an IPv4 address"
+	default:
+		return "value must be an IPv6 address"
+	}
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Empty strings are used by OPNsense for unset values
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	addr, err := netip.ParseAddr(req.ConfigValue.ValueString())
+	if err == nil && ((v.ipv4 && addr.Is4()) || (v.ipv6 && addr.Is6())) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address",
+		fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
+
+// IPv4Address returns a validator which ensures that a configured string is an IPv4 address.
+// Empty strings are accepted.
+func IPv4Address() validator.String {
+	return ipAddressValidator{ipv4: true}
+}
+
+// IPv6Address returns a validator which ensures that a configured string is an IPv6 address.
+// Empty strings are accepted.
+func IPv6Address() validator.String {
+	return ipAddressValidator{ipv6: true}
+}
+
+// IPAddress returns a validator which ensures that a configured string is an IPv4 or IPv6 address.
+// Empty strings are accepted.
+func IPAddress() validator.String {
+	return ipAddressValidator{ipv4: true, ipv6: true}
+}
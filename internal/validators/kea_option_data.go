@@ -0,0 +1,90 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = keaOptionDataValidator{}
+
+var keaOptionHexRegex = regexp.MustCompile(`^[0-9a-fA-F]{2}(:?[0-9a-fA-F]{2})*$`)
+
+// keaOptionDataValidator validates that the data of a Kea option matches the type of the option, which is read from
+// the sibling `type` attribute.
+type keaOptionDataValidator struct{}
+
+func (v keaOptionDataValidator) Description(ctx context.Context) string {
+	return "value must match the option type"
+}
+
+func (v keaOptionDataValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must match the option `type`"
+}
+
+func (v keaOptionDataValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var optType types.String
+	typePath := req.Path.ParentPath().AtName("type")
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, typePath, &optType)...)
+	if resp.Diagnostics.HasError() || optType.IsNull() || optType.IsUnknown() {
+		return
+	}
+
+	if err := keaOptionDataError(optType.ValueString(), req.ConfigValue.ValueString()); err != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Option Data",
+			fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, err, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// keaOptionDataError returns why data is not valid for an option of the given type, or an empty string if it is.
+func keaOptionDataError(optType string, data string) string {
+	switch optType {
+	case "ip":
+		if addr, err := netip.ParseAddr(data); err != nil || !addr.Is4() {
+			return "must be an IPv4 address when type is ip"
+		}
+	case "ip-list":
+		for _, item := range strings.Split(data, ",") {
+			if addr, err := netip.ParseAddr(strings.TrimSpace(item)); err != nil || !addr.Is4() {
+				return "must be a comma separated list of IPv4 addresses when type is ip-list"
+			}
+		}
+	case "string":
+		if data == "" {
+			return "must not be empty when type is string"
+		}
+	case "hex":
+		if !keaOptionHexRegex.MatchString(data) {
+			return "must be hex bytes, optionally colon separated (e.g. 0a:0b), when type is hex"
+		}
+	case "uint8", "uint16", "uint32":
+		bits, _ := strconv.Atoi(strings.TrimPrefix(optType, "uint"))
+		if _, err := strconv.ParseUint(data, 10, bits); err != nil {
+			return fmt.Sprintf("must be an unsigned %d-bit integer when type is %s", bits, optType)
+		}
+	case "boolean":
+		if data != "true" && data != "false" {
+			return "must be true or false when type is boolean"
+		}
+	}
+	return ""
+}
+
+// KeaOptionData returns a validator which ensures that the configured data of a Kea option matches the `type`
+// attribute next to it. Types it does not know are accepted.
+func KeaOptionData() validator.String {
+	return keaOptionDataValidator{}
+}
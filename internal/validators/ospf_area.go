@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ospfAreaValidator{}
+
+// ospfAreaValidator validates that a string is an OSPF area ID, either in dotted decimal
+// (e.g. `0.0.0.0`) or integer (e.g. `0`) notation.
+type ospfAreaValidator struct{}
+
+func (v ospfAreaValidator) Description(ctx context.Context) string {
+	return "value must be an OSPF area ID in dotted decimal (e.g. 0.0.0.1) or integer (e.g. 1) notation"
+}
+
+func (v ospfAreaValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an OSPF area ID in dotted decimal (e.g. `0.0.0.1`) or integer (e.g. `1`) notation"
+}
+
+func (v ospfAreaValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Empty strings are used by OPNsense for unset values
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if addr, err := netip.ParseAddr(value); err == nil && addr.Is4() {
+		return
+	}
+	if _, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid OSPF Area",
+		fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+	)
+}
+
+// OSPFArea returns a validator which ensures that a configured string is an OSPF area ID.
+// Empty strings are accepted.
+func OSPFArea() validator.String {
+	return ospfAreaValidator{}
+}
@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Set = uniqueAttributeValidator{}
+var _ validator.List = uniqueAttributeValidator{}
+
+// uniqueAttributeValidator validates that no two objects in a collection share the same value for an attribute.
+type uniqueAttributeValidator struct {
+	attribute string
+}
+
+func (v uniqueAttributeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("each element must have a unique value for %q", v.attribute)
+}
+
+func (v uniqueAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("each element must have a unique value for `%s`", v.attribute)
+}
+
+func (v uniqueAttributeValidator) validate(elements []attr.Value) (string, bool) {
+	seen := map[string]bool{}
+	for _, element := range elements {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		value, ok := object.Attributes()[v.attribute]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		key := value.String()
+		if seen[key] {
+			return key, false
+		}
+		seen[key] = true
+	}
+	return "", true
+}
+
+func (v uniqueAttributeValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if duplicate, ok := v.validate(req.ConfigValue.Elements()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Duplicate Value",
+			fmt.Sprintf("Attribute %s %s, got duplicate: %s", req.Path, v.Description(ctx), duplicate),
+		)
+	}
+}
+
+func (v uniqueAttributeValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if duplicate, ok := v.validate(req.ConfigValue.Elements()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Duplicate Value",
+			fmt.Sprintf("Attribute %s %s, got duplicate: %s", req.Path, v.Description(ctx), duplicate),
+		)
+	}
+}
+
+// UniqueSetAttribute returns a validator which ensures that no two objects of a set of
+// nested attributes share the same value for the given attribute.
+func UniqueSetAttribute(attribute string) validator.Set {
+	return uniqueAttributeValidator{attribute: attribute}
+}
+
+// UniqueListAttribute returns a validator which ensures that no two objects of a list of
+// nested attributes share the same value for the given attribute.
+func UniqueListAttribute(attribute string) validator.List {
+	return uniqueAttributeValidator{attribute: attribute}
+}